@@ -0,0 +1,42 @@
+//
+//  CommandSnapshot.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+CommandSnapshot The ICommand mappings of a Controller,
+as captured by SnapshotCommands.
+
+It is an alias of the struct type, so that the IController
+interface can spell it without depending on the controller package.
+*/
+type CommandSnapshot = struct {
+	Commands map[string][]func() interfaces.ICommand // Mapping of Notification names to their factories, in execution order
+	Async    map[string]bool                         // Notification names whose ICommands execute asynchronously
+	Disabled map[string]bool                         // Notification names whose ICommands are disabled
+	Once     map[string]bool                         // Notification names whose mapping is removed after its first execution
+}
+
+/*
+copyFlags Copy a set of Notification names.
+
+- parameter flags: the set to copy, or nil
+
+- returns: a copy of the set, never nil
+*/
+func copyFlags(flags map[string]bool) map[string]bool {
+	copied := make(map[string]bool, len(flags))
+	for name, flag := range flags {
+		if flag {
+			copied[name] = true
+		}
+	}
+	return copied
+}
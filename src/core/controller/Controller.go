@@ -9,10 +9,18 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 /*
@@ -38,11 +46,42 @@ and use its initializeController method to add your
 registrations.
 */
 type Controller struct {
-	commandMap      map[string]func() interfaces.ICommand // Mapping of Notification names to funcs that returns ICommand Class instances
-	commandMapMutex sync.RWMutex                          // Mutex for commandMap
-	view            interfaces.IView                      // Local reference to View
+	commandMap       map[string][]func() interfaces.ICommand // Mapping of Notification names to funcs that returns ICommand Class instances, in execution order
+	prefixCommandMap map[string]func() interfaces.ICommand   // Mapping of Notification name prefixes to funcs that returns ICommand Class instances
+	onceCommandMap   map[string]bool                         // Notification names whose mapping is removed after its first execution
+	disabledCommands map[string]bool                         // Notification names whose ICommands are temporarily not executed
+	asyncCommandMap  map[string]bool                         // Notification names whose ICommands execute asynchronously
+	commandMapMutex  sync.RWMutex                            // Mutex for commandMap, prefixCommandMap, onceCommandMap, disabledCommands and asyncCommandMap
+	view             interfaces.IView                        // Local reference to View
+	pool             *workerPool                             // The pool executing asynchronous ICommands, if enabled
+	poolMutex        sync.Mutex                              // Mutex for pool
+	asyncRunning     sync.WaitGroup                          // Asynchronous ICommands running on goroutines of their own
+	facade           interfaces.IFacade                      // The Facade ICommands are initialized with, or nil for the Singleton
+	recoverCommands  atomic.Bool                             // Whether panics of ICommands are recovered
+
+	// MetricsHook, if set, is called after each ICommand executed by
+	// ExecuteCommand completes, with the name of the INotification that
+	// triggered it and the time the ICommand took to execute.
+	// Set it before any INotifications are sent.
+	MetricsHook func(notificationName string, duration time.Duration)
+
+	// OnCommandMapChanged, if set, is called whenever an ICommand
+	// mapping is registered (added is true) or removed (added is
+	// false), with the name of its INotification. It is called
+	// after the command map lock is released, so it may inspect
+	// the Controller. Set it before any ICommands are registered.
+	OnCommandMapChanged func(notificationName string, added bool)
+
+	// CommandErrorHandler, if set, is called with the name of the
+	// INotification and the recovered value when an ICommand panics
+	// while SetRecoverCommands is enabled. Set it before any
+	// INotifications are sent.
+	CommandErrorHandler func(notificationName string, recovered interface{})
 }
 
+// ErrCommandTimeout is returned by ExecuteCommandTimeout when the ICommand does not finish in time.
+var ErrCommandTimeout = errors.New("command timed out")
+
 var instance interfaces.IController // The Singleton Controller instanceMap.
 var instanceMutex sync.RWMutex      // instanceMap Mutex
 
@@ -64,6 +103,87 @@ func GetInstance(factory func() interfaces.IController) interfaces.IController {
 	return instance
 }
 
+/*
+HasInstance Check if the Singleton Controller instance exists, without creating it.
+
+- returns: whether GetInstance has created the instance
+*/
+func HasInstance() bool {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+
+	return instance != nil
+}
+
+/*
+Default Get the Singleton Controller instance, creating a Controller if there is none.
+
+Shorthand for GetInstance(func() interfaces.IController { return &Controller{} }).
+
+- returns: the Singleton instance
+*/
+func Default() interfaces.IController {
+	return GetInstance(func() interfaces.IController { return &Controller{} })
+}
+
+/*
+NewController Create a Controller that is not the Singleton instance.
+
+The Controller is initialized to register the Observers of its
+ICommands with the given IView rather than the Singleton View,
+so it can back an isolated Facade created with facade.NewFacadeWith.
+
+- parameter view: the IView the Controller registers its Observers with
+
+- returns: the initialized Controller
+*/
+func NewController(view interfaces.IView) *Controller {
+	controller := &Controller{view: view}
+	controller.initializeCommandMaps()
+	return controller
+}
+
+/*
+SetNotifierFacade Set the IFacade that the INotifiers registered
+with this Controller are initialized with.
+
+Called by facade.NewFacadeWith. Until it is called, INotifiers
+are initialized with the Singleton Facade.
+
+- parameter facade: the IFacade owning this Controller
+*/
+func (self *Controller) SetNotifierFacade(facade interfaces.IFacade) {
+	self.facade = facade
+}
+
+/*
+initializeNotifier Initialize an INotifier with the IFacade owning
+this Controller, if set and supported, or else with the Singleton Facade.
+
+- parameter notifier: the INotifier to initialize
+*/
+func (self *Controller) initializeNotifier(notifier interfaces.INotifier) {
+	if bound, ok := notifier.(interfaces.IFacadeNotifier); ok && self.facade != nil {
+		bound.InitializeNotifierWith(self.facade)
+		return
+	}
+	notifier.InitializeNotifier()
+}
+
+/*
+RemoveController Remove the Singleton Controller instance.
+
+The next call to GetInstance creates a new instance.
+The command mappings of the removed instance are not
+cleared; remove them first if the View outlives it.
+*/
+func RemoveController() {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+
+	instance = nil
+}
+
 /*
 InitializeController Initialize the Singleton Controller instance.
 
@@ -75,32 +195,296 @@ and override the InitializeController method in the
 following way:
 
 	func (self *MyController) InitializeController() {
-	  self.commandMap = map[string]func() interfaces.ICommand{}
+	  self.Controller.InitializeController()
 	  self.view = MyView.GetInstance(func() interfaces.IView { return &MyView{} })
 	}
 */
 func (self *Controller) InitializeController() {
-	self.commandMap = map[string]func() interfaces.ICommand{}
+	self.initializeCommandMaps()
 	self.view = view.GetInstance(func() interfaces.IView { return &view.View{} })
 }
 
+/*
+initializeCommandMaps Create the empty command maps of the Controller.
+*/
+func (self *Controller) initializeCommandMaps() {
+	self.commandMap = map[string][]func() interfaces.ICommand{}
+	self.prefixCommandMap = map[string]func() interfaces.ICommand{}
+	self.onceCommandMap = map[string]bool{}
+	self.disabledCommands = map[string]bool{}
+	self.asyncCommandMap = map[string]bool{}
+}
+
 /*
 ExecuteCommand If an ICommand has previously been registered
 to handle the given INotification, then it is executed.
 
+An ICommand registered for the exact INotification name takes
+precedence; otherwise the ICommand registered for the longest
+matching prefix, if any, is executed.
+
+ICommands registered with RegisterAdditionalCommand are
+executed in registration order.
+
+If a MetricsHook is set, it is called once the ICommands
+have executed, outside of the command map lock. ICommands
+registered with RegisterCommandAsync are only dispatched by
+then, so the duration does not include their execution.
+
 - parameter note: an INotification
 */
 func (self *Controller) ExecuteCommand(notification interfaces.INotification) {
-	self.commandMapMutex.RLock()
-	defer self.commandMapMutex.RUnlock()
+	start := time.Now()
+	if self.executeCommand(notification) && self.MetricsHook != nil {
+		self.MetricsHook(notification.Name(), time.Since(start))
+	}
+}
 
-	var factory = self.commandMap[notification.Name()]
-	if factory == nil {
-		return
+/*
+ExecuteCommandTimeout Execute the ICommand registered to handle the given
+INotification, waiting at most for the given timeout.
+
+The ICommand is executed by ExecuteCommand on a separate goroutine.
+If it does not finish in time, an error wrapping ErrCommandTimeout
+is returned and the goroutine is left running, detached; Go cannot
+interrupt it, so an ICommand that may hang should itself honor a
+cancellation signal, such as a context carried in the INotification
+body, to actually stop.
+
+- parameter notification: an INotification
+
+- parameter timeout: how long to wait for the ICommand to finish
+
+- returns: nil if the ICommand finished, or no ICommand is registered, in time
+*/
+func (self *Controller) ExecuteCommandTimeout(notification interfaces.INotification, timeout time.Duration) error {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		self.ExecuteCommand(notification)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return nil
+	case <-timer.C:
+		return fmt.Errorf("%w: %s after %v", ErrCommandTimeout, notification.Name(), timeout)
 	}
+}
+
+/*
+executeCommand Execute the ICommands registered for the INotification, if any.
+
+The factories are looked up under the command map lock, which is
+released before the ICommands execute, so that an ICommand
+may itself register or remove ICommands.
+
+A factory returning a nil ICommand is skipped: the misuse is
+logged, or panics in debug mode.
+
+- parameter notification: an INotification
+
+- returns: whether an ICommand was executed
+*/
+func (self *Controller) executeCommand(notification interfaces.INotification) bool {
+	var factories, async = self.lookupCommands(notification.Name())
+	for _, factory := range factories {
+		if async {
+			factory := factory
+			self.executeAsync(func() { self.executeFactory(factory, notification) })
+			continue
+		}
+		self.executeFactory(factory, notification)
+	}
+	return len(factories) > 0
+}
+
+/*
+executeFactory Execute the ICommand returned by a factory for an INotification.
+
+- parameter factory: reference that returns ICommand
+
+- parameter notification: an INotification
+*/
+func (self *Controller) executeFactory(factory func() interfaces.ICommand, notification interfaces.INotification) {
+	if self.recoverCommands.Load() {
+		defer self.recoverCommand(notification)
+	}
+
 	commandInstance := factory()
-	commandInstance.InitializeNotifier()
-	commandInstance.Execute(notification)
+	if isNilCommand(commandInstance) {
+		debug.Report("the ICommand factory for notification %q returned nil", notification.Name())
+		return
+	}
+	self.ExecuteCommandInstance(commandInstance, notification)
+}
+
+/*
+recoverCommand Recover from a panic of an ICommand, and hand it to the CommandErrorHandler.
+
+Without a CommandErrorHandler, the panic is logged, or panics
+again in debug mode. Must be called by a deferred call.
+
+- parameter notification: the INotification the ICommand executed for
+*/
+func (self *Controller) recoverCommand(notification interfaces.INotification) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+	if self.CommandErrorHandler != nil {
+		self.CommandErrorHandler(notification.Name(), recovered)
+		return
+	}
+	debug.Report("the ICommand for notification %q panicked: %v", notification.Name(), recovered)
+}
+
+/*
+SetRecoverCommands Recover from the panics of the ICommands executed by ExecuteCommand.
+
+When enabled, an ICommand that panics does not take down the
+goroutine sending the INotification: the panic is handed to
+the CommandErrorHandler, and the other ICommands and IObservers
+for the INotification are still notified.
+
+- parameter enabled: whether panics of ICommands are recovered
+*/
+func (self *Controller) SetRecoverCommands(enabled bool) {
+	self.recoverCommands.Store(enabled)
+}
+
+/*
+executeAsync Execute an asynchronous ICommand on the worker pool,
+if one is enabled, or else on its own goroutine.
+
+An ICommand submitted while the pool is being shut down or
+replaced also runs on its own goroutine, which Shutdown waits for.
+
+- parameter job: the execution of the ICommand
+*/
+func (self *Controller) executeAsync(job func()) {
+	self.poolMutex.Lock()
+	var pool = self.pool
+	self.poolMutex.Unlock()
+
+	if pool != nil && pool.submit(job) {
+		return
+	}
+	self.asyncRunning.Add(1)
+	go func() {
+		defer self.asyncRunning.Done()
+		job()
+	}()
+}
+
+/*
+ExecuteCommandInstance Execute an ICommand instance for an INotification.
+
+The ICommand does not need to be registered: it is initialized
+and executed as if a registered factory had returned it, which
+makes it easy to drive a single ICommand in a unit test.
+
+- parameter command: the ICommand to execute
+
+- parameter notification: the INotification to execute it with
+*/
+func (self *Controller) ExecuteCommandInstance(command interfaces.ICommand, notification interfaces.INotification) {
+	self.initializeNotifier(command)
+	command.Execute(notification)
+}
+
+/*
+isNilCommand Check whether a factory returned no ICommand,
+either as a nil interface or as a nil pointer.
+
+- parameter command: the ICommand returned by a factory
+
+- returns: whether the ICommand is nil
+*/
+func isNilCommand(command interfaces.ICommand) bool {
+	if command == nil {
+		return true
+	}
+	value := reflect.ValueOf(command)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
+
+/*
+lookupCommands Find the factories of the ICommands that handle the given INotification name.
+
+A mapping registered with RegisterCommandOnce is removed
+as it is looked up. A disabled name has no factories, and its
+once mapping is kept until it is enabled again.
+
+- parameter notificationName: the name of the INotification
+
+- returns: the factories registered for the exact name, else for its longest matching prefix, else nil
+
+- returns: whether the ICommands are executed asynchronously
+*/
+func (self *Controller) lookupCommands(notificationName string) ([]func() interfaces.ICommand, bool) {
+	self.commandMapMutex.RLock()
+	if self.disabledCommands[notificationName] {
+		self.commandMapMutex.RUnlock()
+		return nil, false
+	}
+	var once = self.onceCommandMap[notificationName]
+	var async = self.asyncCommandMap[notificationName]
+	var factories = self.commandMap[notificationName]
+	if factories == nil {
+		if prefix, ok := self.matchPrefix(notificationName); ok {
+			factories = []func() interfaces.ICommand{self.prefixCommandMap[prefix]}
+		}
+	}
+	self.commandMapMutex.RUnlock()
+
+	if once {
+		return self.takeOnceCommands(notificationName), false
+	}
+	return factories, async
+}
+
+/*
+takeOnceCommands Remove a mapping registered with RegisterCommandOnce.
+
+Only the first caller gets the factories, so that the ICommands
+execute once even if the INotification is sent concurrently.
+
+- parameter notificationName: the name of the INotification
+
+- returns: the factories that were registered for the name, if any
+*/
+func (self *Controller) takeOnceCommands(notificationName string) []func() interfaces.ICommand {
+	self.commandMapMutex.Lock()
+	var factories = self.commandMap[notificationName]
+	var removed = self.onceCommandMap[notificationName]
+	if removed {
+		self.view.RemoveObserver(notificationName, self)
+		delete(self.commandMap, notificationName)
+		delete(self.onceCommandMap, notificationName)
+	}
+	self.commandMapMutex.Unlock()
+
+	if removed {
+		self.commandMapChanged(notificationName, false)
+	}
+	return factories
+}
+
+/*
+commandMapChanged Call the OnCommandMapChanged hook, if set.
+
+- parameter notificationName: the name of the INotification whose mapping changed
+
+- parameter added: whether the mapping was registered rather than removed
+*/
+func (self *Controller) commandMapChanged(notificationName string, added bool) {
+	if self.OnCommandMapChanged != nil {
+		self.OnCommandMapChanged(notificationName, added)
+	}
 }
 
 /*
@@ -109,7 +493,8 @@ for a particular INotification.
 
 If an ICommand has already been registered to
 handle INotifications with this name, it is no longer
-used, the new ICommand is used instead.
+used, the new ICommand is used instead. This includes
+any ICommands added with RegisterAdditionalCommand.
 
 The Observer for the new ICommand is only created if this the
 first time an ICommand has been regisered for this Notification name.
@@ -119,13 +504,251 @@ first time an ICommand has been regisered for this Notification name.
 - parameter factory: reference that returns ICommand
 */
 func (self *Controller) RegisterCommand(notificationName string, factory func() interfaces.ICommand) {
+	self.ReplaceCommand(notificationName, factory)
+}
+
+/*
+ReplaceCommand Register a particular ICommand class as the handler
+for a particular INotification, reporting whether it replaces one.
+
+This is RegisterCommand for hot-swapping an ICommand: the
+existing mapping, including any ICommands added with
+RegisterAdditionalCommand, is replaced, and the Observer
+registered for it is kept.
+
+- parameter notificationName: the name of the INotification
+
+- parameter factory: reference that returns ICommand
+
+- returns: whether an ICommand mapping already existed
+*/
+func (self *Controller) ReplaceCommand(notificationName string, factory func() interfaces.ICommand) (hadPrevious bool) {
 	self.commandMapMutex.Lock()
-	defer self.commandMapMutex.Unlock()
+	hadPrevious = self.commandMap[notificationName] != nil
+	if !hadPrevious {
+		self.view.RegisterObserver(notificationName, &observer.Observer{Notify: self.ExecuteCommand, Context: self})
+	}
+	self.commandMap[notificationName] = []func() interfaces.ICommand{factory}
+	delete(self.onceCommandMap, notificationName)
+	delete(self.asyncCommandMap, notificationName)
+	self.commandMapMutex.Unlock()
+
+	self.commandMapChanged(notificationName, true)
+	return hadPrevious
+}
+
+/*
+RegisterCommandAsync Register a particular ICommand class as the handler
+for a particular INotification, executed asynchronously.
+
+As with RegisterCommand, any ICommand already registered for
+the INotification name is replaced. Sending the INotification
+does not wait for the ICommand: it executes on the worker pool
+enabled with EnableWorkerPool, or else on a goroutine of its
+own. ICommands added afterwards with RegisterAdditionalCommand
+execute asynchronously too.
 
+- parameter notificationName: the name of the INotification
+
+- parameter factory: reference that returns ICommand
+*/
+func (self *Controller) RegisterCommandAsync(notificationName string, factory func() interfaces.ICommand) {
+	self.commandMapMutex.Lock()
+	if self.commandMap[notificationName] == nil {
+		self.view.RegisterObserver(notificationName, &observer.Observer{Notify: self.ExecuteCommand, Context: self})
+	}
+	self.commandMap[notificationName] = []func() interfaces.ICommand{factory}
+	delete(self.onceCommandMap, notificationName)
+	self.asyncCommandMap[notificationName] = true
+	self.commandMapMutex.Unlock()
+
+	self.commandMapChanged(notificationName, true)
+}
+
+/*
+EnableWorkerPool Execute the ICommands registered with
+RegisterCommandAsync on a fixed number of goroutines.
+
+The ICommands wait in a queue holding as many of them as
+there are workers; once it is full, sending an INotification
+blocks until a worker is free. An asynchronous ICommand that
+itself sends INotifications handled asynchronously may thus
+wait for its own worker, so size the pool accordingly.
+
+Enabling the pool again replaces it, after the previous pool
+is shut down.
+
+- parameter size: the number of workers, at least 1
+*/
+func (self *Controller) EnableWorkerPool(size int) {
+	if size < 1 {
+		debug.Report("cannot enable a worker pool of size %d", size)
+		return
+	}
+
+	self.poolMutex.Lock()
+	var previous = self.pool
+	self.pool = newWorkerPool(size)
+	self.poolMutex.Unlock()
+
+	if previous != nil {
+		previous.shutdown()
+	}
+}
+
+/*
+Shutdown Shut down the worker pool enabled with EnableWorkerPool,
+if any, waiting for the queued and running ICommands to complete,
+along with the asynchronous ICommands running on goroutines of
+their own.
+
+Asynchronous ICommands executed afterwards run on goroutines
+of their own, until a worker pool is enabled again.
+*/
+func (self *Controller) Shutdown() {
+	self.poolMutex.Lock()
+	var pool = self.pool
+	self.pool = nil
+	self.poolMutex.Unlock()
+
+	if pool != nil {
+		pool.shutdown()
+	}
+	self.asyncRunning.Wait()
+}
+
+/*
+RegisterCommandOnce Register a particular ICommand class as the handler
+for the next INotification with a particular name only.
+
+The mapping is removed when the INotification is first sent,
+before the ICommand executes, so the ICommand runs only once
+even if it sends the INotification again. ICommands added
+afterwards with RegisterAdditionalCommand are removed with it,
+and a later RegisterCommand makes the mapping permanent.
+
+- parameter notificationName: the name of the INotification
+
+- parameter factory: reference that returns ICommand
+*/
+func (self *Controller) RegisterCommandOnce(notificationName string, factory func() interfaces.ICommand) {
+	self.commandMapMutex.Lock()
+	if self.commandMap[notificationName] == nil {
+		self.view.RegisterObserver(notificationName, &observer.Observer{Notify: self.ExecuteCommand, Context: self})
+	}
+	self.commandMap[notificationName] = []func() interfaces.ICommand{factory}
+	self.onceCommandMap[notificationName] = true
+	delete(self.asyncCommandMap, notificationName)
+	self.commandMapMutex.Unlock()
+
+	self.commandMapChanged(notificationName, true)
+}
+
+/*
+RegisterAdditionalCommand Register a particular ICommand class as an
+additional handler for a particular INotification.
+
+Unlike RegisterCommand, which replaces any ICommand already
+registered for the INotification name, this keeps the existing
+ICommands: all of them are executed, in registration order,
+each time the INotification is sent.
+
+- parameter notificationName: the name of the INotification
+
+- parameter factory: reference that returns ICommand
+*/
+func (self *Controller) RegisterAdditionalCommand(notificationName string, factory func() interfaces.ICommand) {
+	self.commandMapMutex.Lock()
 	if self.commandMap[notificationName] == nil {
 		self.view.RegisterObserver(notificationName, &observer.Observer{Notify: self.ExecuteCommand, Context: self})
 	}
-	self.commandMap[notificationName] = factory
+	factories := self.commandMap[notificationName]
+	self.commandMap[notificationName] = append(factories[:len(factories):len(factories)], factory)
+	self.commandMapMutex.Unlock()
+
+	self.commandMapChanged(notificationName, true)
+}
+
+/*
+RegisterCommandPrefix Register a particular ICommand class as the handler
+for every INotification whose name begins with the given prefix.
+
+An ICommand registered for an exact INotification name with
+RegisterCommand always wins over a prefix match, and among
+overlapping prefixes the longest match wins.
+
+If an ICommand has already been registered for this prefix,
+it is no longer used, the new ICommand is used instead.
+
+- parameter prefix: the prefix of the INotification names
+
+- parameter factory: reference that returns ICommand
+*/
+func (self *Controller) RegisterCommandPrefix(prefix string, factory func() interfaces.ICommand) {
+	self.commandMapMutex.Lock()
+	defer self.commandMapMutex.Unlock()
+
+	if self.prefixCommandMap[prefix] == nil {
+		self.view.RegisterPrefixObserver(prefix, &observer.Observer{Notify: func(notification interfaces.INotification) {
+			// only the observer of the prefix that resolves the notification executes it
+			if match, ok := self.resolvePrefix(notification.Name()); ok && match == prefix {
+				self.ExecuteCommand(notification)
+			}
+		}, Context: self})
+	}
+	self.prefixCommandMap[prefix] = factory
+}
+
+/*
+RemoveCommandPrefix Remove a previously registered ICommand to INotification name prefix mapping.
+
+- parameter prefix: the prefix of the INotification names to remove the ICommand mapping for
+*/
+func (self *Controller) RemoveCommandPrefix(prefix string) {
+	self.commandMapMutex.Lock()
+	defer self.commandMapMutex.Unlock()
+
+	if self.prefixCommandMap[prefix] != nil {
+		self.view.RemovePrefixObserver(prefix, self)
+		delete(self.prefixCommandMap, prefix)
+	}
+}
+
+/*
+resolvePrefix Find the prefix mapping that handles the given INotification name.
+
+- parameter notificationName: the name of the INotification
+
+- returns: the longest matching prefix, and false if an exact mapping exists or no prefix matches
+*/
+func (self *Controller) resolvePrefix(notificationName string) (string, bool) {
+	self.commandMapMutex.RLock()
+	defer self.commandMapMutex.RUnlock()
+
+	if self.commandMap[notificationName] != nil {
+		return "", false
+	}
+	return self.matchPrefix(notificationName)
+}
+
+/*
+matchPrefix Find the longest registered prefix of the given INotification name.
+
+The caller must hold the commandMapMutex.
+
+- parameter notificationName: the name of the INotification
+
+- returns: the longest matching prefix, and whether one was found
+*/
+func (self *Controller) matchPrefix(notificationName string) (string, bool) {
+	var match string
+	var found bool
+	for prefix := range self.prefixCommandMap {
+		if strings.HasPrefix(notificationName, prefix) && (!found || len(prefix) > len(match)) {
+			match, found = prefix, true
+		}
+	}
+	return match, found
 }
 
 /*
@@ -142,17 +765,185 @@ func (self *Controller) HasCommand(notificationName string) bool {
 	return self.commandMap[notificationName] != nil
 }
 
+/*
+ListCommandPrefixes List the INotification name prefixes that have an ICommand mapping.
+
+- returns: the prefixes registered with RegisterCommandPrefix, sorted
+*/
+func (self *Controller) ListCommandPrefixes() []string {
+	self.commandMapMutex.RLock()
+	defer self.commandMapMutex.RUnlock()
+
+	prefixes := make([]string, 0, len(self.prefixCommandMap))
+	for prefix := range self.prefixCommandMap {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+/*
+ListCommandNames List the names of the INotifications that have an ICommand mapping.
+
+- returns: the INotification names, sorted
+*/
+func (self *Controller) ListCommandNames() []string {
+	self.commandMapMutex.RLock()
+	defer self.commandMapMutex.RUnlock()
+
+	names := make([]string, 0, len(self.commandMap))
+	for name := range self.commandMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+SetCommandEnabled Enable or disable the execution of the ICommands
+mapped to an INotification name.
+
+A disabled mapping keeps its factories and its Observer, but
+ExecuteCommand neither creates nor executes its ICommands until
+it is enabled again. Removing the mapping also clears its
+disabled state.
+
+- parameter notificationName: the name of the INotification
+
+- parameter enabled: whether the ICommands should be executed
+*/
+func (self *Controller) SetCommandEnabled(notificationName string, enabled bool) {
+	self.commandMapMutex.Lock()
+	defer self.commandMapMutex.Unlock()
+
+	if enabled {
+		delete(self.disabledCommands, notificationName)
+	} else {
+		self.disabledCommands[notificationName] = true
+	}
+}
+
+/*
+CommandCount Count the INotification names that have an ICommand mapping.
+
+- returns: the number of INotification names that have an ICommand mapping
+*/
+func (self *Controller) CommandCount() int {
+	self.commandMapMutex.RLock()
+	defer self.commandMapMutex.RUnlock()
+
+	return len(self.commandMap)
+}
+
+/*
+SnapshotCommands Capture the ICommand mappings of the Controller.
+
+The snapshot maps each INotification name to its factories, in
+execution order, so that RestoreCommands can reinstate them with
+the factory identity preserved, along with the names registered
+with RegisterCommandAsync or RegisterCommandOnce and those disabled
+with SetCommandEnabled. Mappings registered with
+RegisterCommandPrefix are not included.
+
+A plain mapping of names to factories, as first proposed, could
+not carry that state, so the snapshot is a CommandSnapshot instead.
+
+- returns: a copy of the ICommand mappings and their state
+*/
+func (self *Controller) SnapshotCommands() CommandSnapshot {
+	self.commandMapMutex.RLock()
+	defer self.commandMapMutex.RUnlock()
+
+	commands := make(map[string][]func() interfaces.ICommand, len(self.commandMap))
+	for name, factories := range self.commandMap {
+		commands[name] = append([]func() interfaces.ICommand(nil), factories...)
+	}
+	return CommandSnapshot{
+		Commands: commands,
+		Async:    copyFlags(self.asyncCommandMap),
+		Disabled: copyFlags(self.disabledCommands),
+		Once:     copyFlags(self.onceCommandMap),
+	}
+}
+
+/*
+RestoreCommands Reinstate ICommand mappings captured by SnapshotCommands.
+
+Mappings registered since the snapshot are removed and those
+removed since are registered again, along with their Observers.
+Whether each mapping executes asynchronously, is disabled, or is
+removed after its first execution is restored as it was captured.
+OnCommandMapChanged is called for every mapping added or removed,
+after the command map lock is released.
+
+- parameter snapshot: the ICommand mappings to reinstate
+*/
+func (self *Controller) RestoreCommands(snapshot CommandSnapshot) {
+	var added, removed []string
+
+	self.commandMapMutex.Lock()
+	for name := range self.commandMap {
+		if len(snapshot.Commands[name]) == 0 {
+			self.view.RemoveObserver(name, self)
+			delete(self.commandMap, name)
+			removed = append(removed, name)
+		}
+	}
+	for name, factories := range snapshot.Commands {
+		if len(factories) == 0 {
+			continue
+		}
+		if self.commandMap[name] == nil {
+			self.view.RegisterObserver(name, &observer.Observer{Notify: self.ExecuteCommand, Context: self})
+			added = append(added, name)
+		}
+		self.commandMap[name] = append([]func() interfaces.ICommand(nil), factories...)
+	}
+	self.asyncCommandMap = copyFlags(snapshot.Async)
+	self.disabledCommands = copyFlags(snapshot.Disabled)
+	self.onceCommandMap = copyFlags(snapshot.Once)
+	self.commandMapMutex.Unlock()
+
+	for _, name := range removed {
+		self.commandMapChanged(name, false)
+	}
+	for _, name := range added {
+		self.commandMapChanged(name, true)
+	}
+}
+
 /*
 RemoveCommand Remove a previously registered ICommand to INotification mapping.
 
+Every ICommand registered for the INotification name is removed.
+
 - parameter notificationName: the name of the INotification to remove the ICommand mapping for
 */
 func (self *Controller) RemoveCommand(notificationName string) {
-	self.commandMapMutex.Lock()
-	defer self.commandMapMutex.Unlock()
+	self.RemoveCommandB(notificationName)
+}
 
-	if self.commandMap[notificationName] != nil {
-		self.view.RemoveObserver(notificationName, self)
-		delete(self.commandMap, notificationName)
+/*
+RemoveCommandB Remove a previously registered ICommand to INotification mapping,
+reporting whether there was one.
+
+- parameter notificationName: the name of the INotification to remove the ICommand mapping for
+
+- returns: whether an ICommand mapping was registered and removed
+*/
+func (self *Controller) RemoveCommandB(notificationName string) bool {
+	self.commandMapMutex.Lock()
+	if self.commandMap[notificationName] == nil {
+		self.commandMapMutex.Unlock()
+		return false
 	}
+	self.view.RemoveObserver(notificationName, self)
+	delete(self.commandMap, notificationName)
+	delete(self.onceCommandMap, notificationName)
+	delete(self.disabledCommands, notificationName)
+	delete(self.asyncCommandMap, notificationName)
+	self.commandMapMutex.Unlock()
+
+	self.commandMapChanged(notificationName, false)
+	return true
 }
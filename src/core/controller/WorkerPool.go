@@ -0,0 +1,89 @@
+//
+//  WorkerPool.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import "sync"
+
+/*
+workerPool A fixed number of goroutines executing jobs from a bounded queue.
+
+Submitting a job blocks while the queue is full, which
+applies backpressure to the goroutine sending INotifications.
+*/
+type workerPool struct {
+	jobs       chan func()    // The queue of jobs waiting for a worker
+	workers    sync.WaitGroup // The running workers
+	submitting sync.WaitGroup // The submissions waiting for room in the queue
+	closed     bool           // Whether the pool was shut down
+	mutex      sync.Mutex     // Mutex for closed and the submitting WaitGroup
+}
+
+/*
+newWorkerPool Start a pool of the given number of workers.
+
+The queue holds as many jobs as there are workers.
+
+- parameter size: the number of workers
+
+- returns: the started pool
+*/
+func newWorkerPool(size int) *workerPool {
+	pool := &workerPool{jobs: make(chan func(), size)}
+	pool.workers.Add(size)
+	for i := 0; i < size; i++ {
+		go func() {
+			defer pool.workers.Done()
+			for job := range pool.jobs {
+				job()
+			}
+		}()
+	}
+	return pool
+}
+
+/*
+submit Queue a job for a worker, waiting for room in the queue.
+
+A job submitted after the pool was shut down is not queued,
+leaving the caller to execute it elsewhere.
+
+- parameter job: the job to execute
+
+- returns: whether the job was queued
+*/
+func (self *workerPool) submit(job func()) bool {
+	self.mutex.Lock()
+	if self.closed {
+		self.mutex.Unlock()
+		return false
+	}
+	self.submitting.Add(1)
+	self.mutex.Unlock()
+
+	defer self.submitting.Done()
+	self.jobs <- job
+	return true
+}
+
+/*
+shutdown Stop accepting jobs, and wait for the queued and running ones to complete.
+*/
+func (self *workerPool) shutdown() {
+	self.mutex.Lock()
+	if self.closed {
+		self.mutex.Unlock()
+		return
+	}
+	self.closed = true
+	self.mutex.Unlock()
+
+	self.submitting.Wait()
+	close(self.jobs)
+	self.workers.Wait()
+}
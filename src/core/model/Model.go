@@ -9,8 +9,13 @@
 package model
 
 import (
+	"encoding/json"
+	"fmt"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"sort"
 	"sync"
+	"sync/atomic"
 )
 
 /*
@@ -32,8 +37,24 @@ instances once the Facade has initialized the Core
 actors.
 */
 type Model struct {
-	proxyMap      map[string]interfaces.IProxy // Mapping of proxyNames to IProxy instances
-	proxyMapMutex sync.RWMutex                 // Mutex for proxyMap
+	proxyMap            map[string]interfaces.IProxy            // Mapping of proxyNames to IProxy instances
+	registering         map[string]chan struct{}                // Mapping of proxyNames being registered by RetrieveProxyOrRegister to channels closed once they are
+	proxyMapMutex       sync.RWMutex                            // Mutex for proxyMap and registering
+	proxyObservers      map[string][]func(old, new interface{}) // Mapping of proxyNames to data change observers
+	proxyObserversMutex sync.RWMutex                            // Mutex for proxyObservers
+	accessMetrics       atomic.Bool                             // Whether RetrieveProxy counts accesses
+	accessCounts        map[string]int                          // Mapping of proxyNames to the number of times they were retrieved
+	accessCountsMutex   sync.Mutex                              // Mutex for accessCounts
+	proxyDataMutex      sync.Mutex                              // Mutex serializing UpdateProxyData
+	facade              interfaces.IFacade                      // The Facade IProxy instances are initialized with, or nil for the Singleton
+}
+
+/*
+dataObservable An IProxy that reports changes to its data object,
+such as the base Proxy.
+*/
+type dataObservable interface {
+	SetDataObserver(observer func(old, new interface{}))
 }
 
 var instance interfaces.IModel // The Singleton Model instance.
@@ -57,6 +78,85 @@ func GetInstance(factory func() interfaces.IModel) interfaces.IModel {
 	return instance
 }
 
+/*
+HasInstance Check if the Singleton Model instance exists, without creating it.
+
+- returns: whether GetInstance has created the instance
+*/
+func HasInstance() bool {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+
+	return instance != nil
+}
+
+/*
+Default Get the Singleton Model instance, creating a Model if there is none.
+
+Shorthand for GetInstance(func() interfaces.IModel { return &Model{} }).
+
+- returns: the Singleton instance
+*/
+func Default() interfaces.IModel {
+	return GetInstance(func() interfaces.IModel { return &Model{} })
+}
+
+/*
+NewModel Create a Model that is not the Singleton instance.
+
+The Model is initialized, and is independent of the Singleton
+and of any other Model created this way, so it can back an
+isolated Facade created with facade.NewFacadeWith.
+
+- returns: the initialized Model
+*/
+func NewModel() *Model {
+	model := &Model{}
+	model.InitializeModel()
+	return model
+}
+
+/*
+SetNotifierFacade Set the IFacade that the INotifiers registered
+with this Model are initialized with.
+
+Called by facade.NewFacadeWith. Until it is called, INotifiers
+are initialized with the Singleton Facade.
+
+- parameter facade: the IFacade owning this Model
+*/
+func (self *Model) SetNotifierFacade(facade interfaces.IFacade) {
+	self.facade = facade
+}
+
+/*
+initializeNotifier Initialize an INotifier with the IFacade owning
+this Model, if set and supported, or else with the Singleton Facade.
+
+- parameter notifier: the INotifier to initialize
+*/
+func (self *Model) initializeNotifier(notifier interfaces.INotifier) {
+	if bound, ok := notifier.(interfaces.IFacadeNotifier); ok && self.facade != nil {
+		bound.InitializeNotifierWith(self.facade)
+		return
+	}
+	notifier.InitializeNotifier()
+}
+
+/*
+RemoveModel Remove the Singleton Model instance.
+
+The next call to GetInstance creates a new instance.
+The IProxy instances of the removed instance are not
+removed, so their OnRemove is not called.
+*/
+func RemoveModel() {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+
+	instance = nil
+}
+
 /*
 InitializeModel Initialize the Model instance.
 
@@ -67,20 +167,154 @@ constructor.
 */
 func (self *Model) InitializeModel() {
 	self.proxyMap = map[string]interfaces.IProxy{}
+	self.registering = map[string]chan struct{}{}
+	self.proxyObservers = map[string][]func(old, new interface{}){}
 }
 
 /*
 RegisterProxy Register an IProxy with the Model.
 
+If an IProxy is already registered under the same name,
+it is overwritten without having its OnRemove called;
+use RegisterProxyReplace to retire it properly.
+
+OnRegister completes before the IProxy is stored, so no other
+goroutine can retrieve it before it is initialized. It is called
+outside the proxy map lock, so that it may itself register or
+remove IProxy instances, but it cannot retrieve its own IProxy.
+
+An IProxy with an empty name is not registered: the misuse
+is logged, or panics in debug mode.
+
 - parameter proxy: an IProxy to be held by the Model.
 */
 func (self *Model) RegisterProxy(proxy interfaces.IProxy) {
+	if !validProxyName(proxy) {
+		return
+	}
+	self.initializeNotifier(proxy)
+	proxy.OnRegister()
+
 	self.proxyMapMutex.Lock()
-	defer self.proxyMapMutex.Unlock()
+	self.proxyMap[proxy.GetProxyName()] = proxy
+	self.proxyMapMutex.Unlock()
 
-	proxy.InitializeNotifier()
+	self.attachProxy(proxy)
+}
+
+/*
+RegisterProxyReplace Register an IProxy with the Model,
+replacing any IProxy already registered under the same name.
+
+As with RegisterProxy, the new IProxy has its OnRegister
+called before it is stored, so the existing IProxy stays
+retrievable until its replacement is initialized. The existing
+IProxy then has its OnRemove called. Both hooks are called
+outside the proxy map lock.
+
+An IProxy with an empty name is not registered, as with RegisterProxy.
+
+- parameter proxy: an IProxy to be held by the Model.
+*/
+func (self *Model) RegisterProxyReplace(proxy interfaces.IProxy) {
+	if !validProxyName(proxy) {
+		return
+	}
+	self.initializeNotifier(proxy)
+	proxy.OnRegister()
+
+	self.proxyMapMutex.Lock()
+	var existing = self.proxyMap[proxy.GetProxyName()]
 	self.proxyMap[proxy.GetProxyName()] = proxy
+	self.proxyMapMutex.Unlock()
+
+	self.attachProxy(proxy)
+	if existing != nil && existing != proxy {
+		self.detachProxy(existing)
+		existing.OnRemove()
+	}
+}
+
+/*
+RetrieveProxyOrRegister Retrieve an IProxy from the Model,
+registering one created by the factory if there is none.
+
+Concurrent callers for the same name all get the same IProxy:
+the first one calls the factory, outside the proxy map lock,
+and the others wait for its registration rather than calling
+the factory again. The factory must not retrieve proxyName from
+the Model. As with RegisterProxy, OnRegister completes before
+the IProxy is stored. If the factory or OnRegister panics, the
+waiting callers are released and nothing is registered.
+
+The factory's IProxy must be non-nil and named proxyName;
+otherwise it is not registered, nil is returned, and the misuse
+is logged, or panics in debug mode.
+
+- parameter proxyName: the name of the IProxy
+
+- parameter factory: reference that returns the IProxy to register
+
+- returns: the IProxy registered under proxyName
+*/
+func (self *Model) RetrieveProxyOrRegister(proxyName string, factory func() interfaces.IProxy) interfaces.IProxy {
+	self.proxyMapMutex.Lock()
+	for self.registering[proxyName] != nil {
+		var ready = self.registering[proxyName]
+		self.proxyMapMutex.Unlock()
+		<-ready
+		self.proxyMapMutex.Lock()
+	}
+	if existing := self.proxyMap[proxyName]; existing != nil {
+		self.proxyMapMutex.Unlock()
+		return existing
+	}
+	var ready = make(chan struct{})
+	self.registering[proxyName] = ready
+	self.proxyMapMutex.Unlock()
+
+	// release the waiting callers even if the factory or OnRegister panics
+	defer func() {
+		self.proxyMapMutex.Lock()
+		delete(self.registering, proxyName)
+		self.proxyMapMutex.Unlock()
+		close(ready)
+	}()
+
+	var proxy = factory()
+	if proxy == nil {
+		debug.Report("cannot register a nil proxy as %q", proxyName)
+		return nil
+	}
+	if proxyName == "" || proxy.GetProxyName() != proxyName {
+		debug.Report("cannot register a proxy named %q as %q", proxy.GetProxyName(), proxyName)
+		return nil
+	}
+
+	self.initializeNotifier(proxy)
 	proxy.OnRegister()
+
+	self.proxyMapMutex.Lock()
+	self.proxyMap[proxyName] = proxy
+	self.proxyMapMutex.Unlock()
+
+	self.attachProxy(proxy)
+	return proxy
+}
+
+/*
+validProxyName Check that an IProxy has a name to be registered under.
+
+- parameter proxy: the IProxy to register
+
+- returns: false, after reporting the misuse, if the name is empty
+*/
+func validProxyName(proxy interfaces.IProxy) bool {
+	if proxy.GetProxyName() == "" {
+		debug.Report("cannot register a proxy of type %T with an empty name", proxy)
+		return false
+	}
+	return true
 }
 
 /*
@@ -91,26 +325,170 @@ RetrieveProxy Retrieve an IProxy from the Model.
 - returns: the IProxy instance previously registered with the given proxyName.
 */
 func (self *Model) RetrieveProxy(proxyName string) interfaces.IProxy {
+	var proxy = self.lookupProxy(proxyName)
+
+	if proxy != nil && self.accessMetrics.Load() {
+		self.accessCountsMutex.Lock()
+		if self.accessCounts != nil {
+			self.accessCounts[proxyName]++
+		}
+		self.accessCountsMutex.Unlock()
+	}
+	return proxy
+}
+
+/*
+lookupProxy Look up an IProxy for the Model's own use,
+without counting it as an access.
+
+- parameter proxyName: the name of the IProxy
+
+- returns: the IProxy registered with the given proxyName, or nil
+*/
+func (self *Model) lookupProxy(proxyName string) interfaces.IProxy {
 	self.proxyMapMutex.RLock()
 	defer self.proxyMapMutex.RUnlock()
 
 	return self.proxyMap[proxyName]
 }
 
+/*
+UpdateProxyData Replace the data of an IProxy with a value
+computed from its current data, atomically.
+
+Calls to UpdateProxyData are serialized under a lock of the
+Model, so concurrent read-modify-write updates such as
+incrementing a counter do not race. Plain GetData and SetData
+calls are not synchronized with it. The fn, and the observers
+of the data it triggers, must not call UpdateProxyData.
+
+Nothing happens if no IProxy is registered under the name.
+
+- parameter proxyName: the name of the IProxy
+
+- parameter fn: the func computing the new data from the old
+*/
+func (self *Model) UpdateProxyData(proxyName string, fn func(old interface{}) interface{}) {
+	var proxy = self.lookupProxy(proxyName)
+	if proxy == nil {
+		return
+	}
+
+	self.proxyDataMutex.Lock()
+	defer self.proxyDataMutex.Unlock()
+
+	proxy.SetData(fn(proxy.GetData()))
+}
+
+/*
+EnableAccessMetrics Turn the counting of IProxy retrievals on or off.
+
+While on, each RetrieveProxy call that finds an IProxy is
+counted, under a lock of its own. Turning it off discards
+the counts.
+
+- parameter enabled: whether to count retrievals
+*/
+func (self *Model) EnableAccessMetrics(enabled bool) {
+	self.accessCountsMutex.Lock()
+	defer self.accessCountsMutex.Unlock()
+
+	if enabled && self.accessCounts == nil {
+		self.accessCounts = map[string]int{}
+	} else if !enabled {
+		self.accessCounts = nil
+	}
+	self.accessMetrics.Store(enabled)
+}
+
+/*
+ProxyAccessCount Get the number of times an IProxy was retrieved
+since access metrics were enabled.
+
+- parameter proxyName: the name of the IProxy
+
+- returns: the number of RetrieveProxy calls that found the IProxy
+*/
+func (self *Model) ProxyAccessCount(proxyName string) int {
+	self.accessCountsMutex.Lock()
+	defer self.accessCountsMutex.Unlock()
+
+	return self.accessCounts[proxyName]
+}
+
+/*
+ListProxyNames List the names of the registered IProxy instances.
+
+- returns: the IProxy names, sorted
+*/
+func (self *Model) ListProxyNames() []string {
+	self.proxyMapMutex.RLock()
+	defer self.proxyMapMutex.RUnlock()
+
+	names := make([]string, 0, len(self.proxyMap))
+	for name := range self.proxyMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+ProxyCount Count the registered IProxy instances.
+
+- returns: the number of registered IProxy instances
+*/
+func (self *Model) ProxyCount() int {
+	self.proxyMapMutex.RLock()
+	defer self.proxyMapMutex.RUnlock()
+
+	return len(self.proxyMap)
+}
+
+/*
+RetrieveAllProxies Retrieve every IProxy registered with the Model.
+
+The returned slice is a snapshot that does not alias the Model's
+own storage; registering or removing IProxy instances afterwards
+does not change it.
+
+- returns: the registered IProxy instances, ordered by name
+*/
+func (self *Model) RetrieveAllProxies() []interfaces.IProxy {
+	self.proxyMapMutex.RLock()
+	defer self.proxyMapMutex.RUnlock()
+
+	names := make([]string, 0, len(self.proxyMap))
+	for name := range self.proxyMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	proxies := make([]interfaces.IProxy, len(names))
+	for i, name := range names {
+		proxies[i] = self.proxyMap[name]
+	}
+	return proxies
+}
+
 /*
 RemoveProxy Remove an IProxy from the Model.
 
+OnRemove is called after the proxy map lock is released,
+so that it may itself register or remove IProxy instances.
+
 - parameter proxyName: name of the IProxy instance to be removed.
 
 - returns: the IProxy that was removed from the Model
 */
 func (self *Model) RemoveProxy(proxyName string) interfaces.IProxy {
 	self.proxyMapMutex.Lock()
-	defer self.proxyMapMutex.Unlock()
-
 	var proxy = self.proxyMap[proxyName]
+	delete(self.proxyMap, proxyName)
+	self.proxyMapMutex.Unlock()
+
 	if proxy != nil {
-		delete(self.proxyMap, proxyName)
+		self.detachProxy(proxy)
 		proxy.OnRemove()
 	}
 	return proxy
@@ -129,3 +507,129 @@ func (self *Model) HasProxy(proxyName string) bool {
 
 	return self.proxyMap[proxyName] != nil
 }
+
+/*
+ObserveProxy Observe changes to the data object of a named IProxy.
+
+The observer is called with the old and the new data object
+each time SetData is called on the IProxy while it is registered,
+including IProxy instances registered after the observer.
+Only IProxy implementations that report their data changes,
+such as the base Proxy, are observable; changes made during
+OnRegister or OnRemove are not reported.
+
+- parameter proxyName: the name of the IProxy to observe
+
+- parameter observer: the func called with the old and the new data object
+*/
+func (self *Model) ObserveProxy(proxyName string, observer func(old, new interface{})) {
+	self.proxyObserversMutex.Lock()
+	defer self.proxyObserversMutex.Unlock()
+
+	self.proxyObservers[proxyName] = append(self.proxyObservers[proxyName], observer)
+}
+
+/*
+attachProxy Route the data changes of a registered IProxy to its observers.
+
+- parameter proxy: the registered IProxy
+*/
+func (self *Model) attachProxy(proxy interfaces.IProxy) {
+	if observable, ok := proxy.(dataObservable); ok {
+		var proxyName = proxy.GetProxyName()
+		observable.SetDataObserver(func(old, new interface{}) {
+			self.notifyProxyObservers(proxyName, old, new)
+		})
+	}
+}
+
+/*
+detachProxy Stop routing the data changes of an IProxy being removed.
+
+- parameter proxy: the IProxy being removed
+*/
+func (self *Model) detachProxy(proxy interfaces.IProxy) {
+	if observable, ok := proxy.(dataObservable); ok {
+		observable.SetDataObserver(nil)
+	}
+}
+
+/*
+notifyProxyObservers Notify the observers of an IProxy that its data object changed.
+
+The observers are called outside of the lock, so that they
+may themselves observe proxies.
+
+- parameter proxyName: the name of the IProxy
+
+- parameter old: the previous data object
+
+- parameter new: the new data object
+*/
+func (self *Model) notifyProxyObservers(proxyName string, old, new interface{}) {
+	self.proxyObserversMutex.RLock()
+	var observers = make([]func(old, new interface{}), len(self.proxyObservers[proxyName]))
+	copy(observers, self.proxyObservers[proxyName])
+	self.proxyObserversMutex.RUnlock()
+
+	for _, observer := range observers {
+		observer(old, new)
+	}
+}
+
+/*
+Export Serialize the data of every registered IProxy.
+
+IProxy instances are encoded with their MarshalJSON, which
+the base Proxy implements by encoding its name and data object;
+their data objects must be JSON-serializable.
+
+- returns: the JSON encoding of the registered IProxy instances, ordered by name
+*/
+func (self *Model) Export() ([]byte, error) {
+	return json.Marshal(self.RetrieveAllProxies())
+}
+
+/*
+Import Restore the data of the registered IProxy instances from the output of Export.
+
+Entries are matched to registered IProxy instances by name;
+entries without a registered IProxy are skipped, since the
+Model cannot create IProxy instances. IProxy instances are
+decoded with their UnmarshalJSON, which the base Proxy
+implements by decoding into the type of its current data
+object; other IProxy instances are set generic data.
+
+- parameter input: the JSON encoding of IProxy instances
+
+- returns: an error if the input or an IProxy's data cannot be decoded
+*/
+func (self *Model) Import(input []byte) error {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(input, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		var decoded struct {
+			Name string      `json:"name"`
+			Data interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(entry, &decoded); err != nil {
+			return err
+		}
+
+		var proxy = self.lookupProxy(decoded.Name)
+		if proxy == nil {
+			continue
+		}
+		if unmarshaler, ok := proxy.(json.Unmarshaler); ok {
+			if err := unmarshaler.UnmarshalJSON(entry); err != nil {
+				return fmt.Errorf("import proxy %q: %w", decoded.Name, err)
+			}
+		} else {
+			proxy.SetData(decoded.Data)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+//
+//  DispatchMetric.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "time"
+
+/*
+DispatchMetric The dispatch metrics of an INotification name,
+as reported by DispatchMetrics.
+
+It is an alias of the struct type, so that the IView interface
+can spell it without depending on the view package.
+*/
+type DispatchMetric = struct {
+	Count int           // The number of NotifyObservers calls
+	Total time.Duration // The total time they spent notifying IObservers
+}
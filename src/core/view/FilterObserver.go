@@ -0,0 +1,35 @@
+//
+//  FilterObserver.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+filterObserver An IObserver decorator that only notifies the
+wrapped IObserver of INotifications accepted by its filter.
+
+All other IObserver methods, including CompareNotifyContext
+and Equals, are delegated to the wrapped IObserver, so a filtered
+observer is removed the same way as the observer it wraps.
+*/
+type filterObserver struct {
+	interfaces.IObserver
+	accept func(notification interfaces.INotification) bool
+}
+
+/*
+NotifyObserver Notify the wrapped IObserver if the filter accepts the INotification.
+
+- parameter notification: the INotification to pass to the wrapped IObserver.
+*/
+func (self *filterObserver) NotifyObserver(notification interfaces.INotification) {
+	if self.accept(notification) {
+		self.IObserver.NotifyObserver(notification)
+	}
+}
@@ -0,0 +1,57 @@
+//
+//  KeyedObserver.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+keyedObserver An IObserver decorator that carries the key
+given to the wrapped IObserver with RegisterObserverKeyed.
+
+All IObserver methods are delegated to the wrapped IObserver.
+*/
+type keyedObserver struct {
+	interfaces.IObserver
+	key string
+}
+
+/*
+observerKey Get the key of an IObserver in an observer list.
+
+- parameter observer: an IObserver from an observer list
+
+- returns: the key given with RegisterObserverKeyed, and whether there is one
+*/
+func observerKey(observer interfaces.IObserver) (string, bool) {
+	if prioritized, ok := observer.(*priorityObserver); ok {
+		observer = prioritized.IObserver
+	}
+	if keyed, ok := observer.(*keyedObserver); ok {
+		return keyed.key, true
+	}
+	return "", false
+}
+
+/*
+removeKeyedObserver Remove the IObserver with a given key from an observer list.
+
+- parameter observers: the observer list
+
+- parameter key: the key of the IObserver to remove
+
+- returns: the observer list without the IObserver, and whether it was found
+*/
+func removeKeyedObserver(observers []interfaces.IObserver, key string) ([]interfaces.IObserver, bool) {
+	for index, observer := range observers {
+		if registeredKey, ok := observerKey(observer); ok && registeredKey == key {
+			return append(observers[:index:index], observers[index+1:]...), true
+		}
+	}
+	return observers, false
+}
@@ -0,0 +1,53 @@
+//
+//  ObserverPool.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"sync"
+)
+
+// maxPooledObservers is the capacity above which a working array is left to the garbage collector.
+const maxPooledObservers = 256
+
+// observerPool holds the working arrays of IObservers reused by NotifyObservers.
+var observerPool = sync.Pool{New: func() interface{} {
+	observers := make([]interfaces.IObserver, 0, 8)
+	return &observers
+}}
+
+/*
+getObservers Get an empty working array of IObservers from the pool.
+
+- returns: a reference to the working array, to be returned with putObservers
+*/
+func getObservers() *[]interfaces.IObserver {
+	return observerPool.Get().(*[]interfaces.IObserver)
+}
+
+/*
+putObservers Return a working array of IObservers to the pool.
+
+The IObservers are cleared first, so that the pool does not
+keep them alive, and an array that grew too large is dropped.
+
+- parameter pooled: the reference returned by getObservers
+
+- parameter observers: the working array, as filled since
+*/
+func putObservers(pooled *[]interfaces.IObserver, observers []interfaces.IObserver) {
+	if cap(observers) > maxPooledObservers {
+		return
+	}
+	for i := range observers {
+		observers[i] = nil
+	}
+	*pooled = observers[:0]
+	observerPool.Put(pooled)
+}
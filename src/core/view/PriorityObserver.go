@@ -0,0 +1,62 @@
+//
+//  PriorityObserver.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+priorityObserver An IObserver decorator that carries the priority
+given to the wrapped IObserver with SetObserverPriority.
+
+All IObserver methods are delegated to the wrapped IObserver.
+*/
+type priorityObserver struct {
+	interfaces.IObserver
+	priority int
+}
+
+/*
+observerPriority Get the priority of an IObserver in an observer list.
+
+- parameter observer: an IObserver from an observer list
+
+- returns: the priority set with SetObserverPriority, or 0
+*/
+func observerPriority(observer interfaces.IObserver) int {
+	if prioritized, ok := observer.(*priorityObserver); ok {
+		return prioritized.priority
+	}
+	return 0
+}
+
+/*
+insertObserver Insert an IObserver into an observer list ordered by descending priority.
+
+The IObserver is inserted after every IObserver of the same or
+a higher priority, so IObservers of equal priority are notified
+in the order they were inserted.
+
+- parameter observers: the observer list
+
+- parameter observer: the IObserver to insert
+
+- returns: the observer list including the IObserver
+*/
+func insertObserver(observers []interfaces.IObserver, observer interfaces.IObserver) []interfaces.IObserver {
+	priority := observerPriority(observer)
+	index := len(observers)
+	for index > 0 && observerPriority(observers[index-1]) < priority {
+		index--
+	}
+
+	observers = append(observers, nil)
+	copy(observers[index+1:], observers[index:])
+	observers[index] = observer
+	return observers
+}
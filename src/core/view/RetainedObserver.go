@@ -0,0 +1,44 @@
+//
+//  RetainedObserver.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+retainedObserver An IObserver decorator that counts the
+RetainObserver calls not yet matched by a ReleaseObserver.
+
+All IObserver methods are delegated to the wrapped IObserver.
+The count is guarded by the View's observer map lock.
+*/
+type retainedObserver struct {
+	interfaces.IObserver
+	count int
+}
+
+/*
+findRetainedObserver Find the retained IObserver for a notifyContext in an observer list.
+
+- parameter observers: the observer list
+
+- parameter notifyContext: the notifyContext of the IObserver
+
+- returns: the index of the IObserver and its retainedObserver, or -1 and nil
+*/
+func findRetainedObserver(observers []interfaces.IObserver, notifyContext interface{}) (int, *retainedObserver) {
+	for index, observer := range observers {
+		if prioritized, ok := observer.(*priorityObserver); ok {
+			observer = prioritized.IObserver
+		}
+		if retained, ok := observer.(*retainedObserver); ok && retained.CompareNotifyContext(notifyContext) {
+			return index, retained
+		}
+	}
+	return -1, nil
+}
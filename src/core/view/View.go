@@ -9,9 +9,17 @@
 package view
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 /*
@@ -33,12 +41,29 @@ In PureMVC, the View class assumes these responsibilities:
 * Notifying the IObservers of a given INotification when it broadcast.
 */
 type View struct {
-	mediatorMap      map[string]interfaces.IMediator   // Mapping of Mediator names to Mediator instances
-	observerMap      map[string][]interfaces.IObserver // Mapping of Notification names to Observer lists
-	mediatorMapMutex sync.RWMutex                      // Mutex for mediatorMap
-	observerMapMutex sync.RWMutex                      // Mutex for observerMap
+	mediatorMap       map[string]interfaces.IMediator   // Mapping of Mediator names to Mediator instances
+	observerMap       map[string][]interfaces.IObserver // Mapping of Notification names to Observer lists
+	prefixObserverMap map[string][]interfaces.IObserver // Mapping of Notification name prefixes to Observer lists
+	mediatorGroups    map[string]string                 // Mapping of Mediator names to the group they were registered in
+	mediatorOrder     map[string]uint64                 // Mapping of Mediator names to their registration index
+	mediatorSequence  uint64                            // The registration index of the last registered Mediator
+	mediatorInterests map[string][]string               // Mapping of Mediator names to the interests they were registered with
+	mediatorMapMutex  sync.RWMutex                      // Mutex for mediatorMap, mediatorGroups, mediatorOrder, mediatorSequence and mediatorInterests
+	observerMapMutex  sync.RWMutex                      // Mutex for observerMap and prefixObserverMap
+	facade            interfaces.IFacade                // The Facade IMediators are initialized with, or nil for the Singleton
+
+	dispatchMetrics      atomic.Bool               // Whether NotifyObservers measures dispatches
+	dispatchMetricsMap   map[string]DispatchMetric // Mapping of Notification names to their dispatch metrics
+	dispatchMetricsMutex sync.Mutex                // Mutex for dispatchMetricsMap
+
+	sticky              atomic.Bool                         // Whether any Notification name is sticky
+	stickyNotifications map[string]interfaces.INotification // Mapping of sticky Notification names to their last Notification, or nil
+	stickyMutex         sync.RWMutex                        // Mutex for stickyNotifications
 }
 
+// ErrMediatorExists is returned by RegisterMediatorsStrict when a mediator name is already taken.
+var ErrMediatorExists = errors.New("mediator already registered")
+
 var instance interfaces.IView      // The Singleton View instance.
 var instanceMutex = sync.RWMutex{} // instanceMutex
 
@@ -60,6 +85,85 @@ func GetInstance(factory func() interfaces.IView) interfaces.IView {
 	return instance
 }
 
+/*
+HasInstance Check if the Singleton View instance exists, without creating it.
+
+- returns: whether GetInstance has created the instance
+*/
+func HasInstance() bool {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+
+	return instance != nil
+}
+
+/*
+Default Get the Singleton View instance, creating a View if there is none.
+
+Shorthand for GetInstance(func() interfaces.IView { return &View{} }).
+
+- returns: the Singleton instance
+*/
+func Default() interfaces.IView {
+	return GetInstance(func() interfaces.IView { return &View{} })
+}
+
+/*
+NewView Create a View that is not the Singleton instance.
+
+The View is initialized, and is independent of the Singleton
+and of any other View created this way, so it can back an
+isolated Facade created with facade.NewFacadeWith.
+
+- returns: the initialized View
+*/
+func NewView() *View {
+	view := &View{}
+	view.InitializeView()
+	return view
+}
+
+/*
+SetNotifierFacade Set the IFacade that the INotifiers registered
+with this View are initialized with.
+
+Called by facade.NewFacadeWith. Until it is called, INotifiers
+are initialized with the Singleton Facade.
+
+- parameter facade: the IFacade owning this View
+*/
+func (self *View) SetNotifierFacade(facade interfaces.IFacade) {
+	self.facade = facade
+}
+
+/*
+initializeNotifier Initialize an INotifier with the IFacade owning
+this View, if set and supported, or else with the Singleton Facade.
+
+- parameter notifier: the INotifier to initialize
+*/
+func (self *View) initializeNotifier(notifier interfaces.INotifier) {
+	if bound, ok := notifier.(interfaces.IFacadeNotifier); ok && self.facade != nil {
+		bound.InitializeNotifierWith(self.facade)
+		return
+	}
+	notifier.InitializeNotifier()
+}
+
+/*
+RemoveView Remove the Singleton View instance.
+
+The next call to GetInstance creates a new instance.
+The IMediator instances of the removed instance are not
+removed, so their OnRemove is not called.
+*/
+func RemoveView() {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+
+	instance = nil
+}
+
 /*
 InitializeView Initialize the Singleton View instance.
 
@@ -71,87 +175,913 @@ constructor.
 func (self *View) InitializeView() {
 	self.mediatorMap = map[string]interfaces.IMediator{}
 	self.observerMap = map[string][]interfaces.IObserver{}
+	self.prefixObserverMap = map[string][]interfaces.IObserver{}
+	self.mediatorGroups = map[string]string{}
+	self.mediatorOrder = map[string]uint64{}
+	self.mediatorInterests = map[string][]string{}
 }
 
 /*
 RegisterObserver Register an IObserver to be notified
 of INotifications with a given name.
 
+IObservers are notified in the order they were registered,
+unless SetObserverPriority changed their priority; the new
+IObserver has priority 0, so it is notified after IObservers of
+a higher priority and before IObservers of a lower priority.
+
+If the INotification name is sticky and one was already sent,
+the IObserver is notified of it right away.
+
 - parameter notificationName: the name of the INotifications to notify this IObserver of
 
 - parameter observer: the IObserver to register
 */
 func (self *View) RegisterObserver(notificationName string, observer interfaces.IObserver) {
+	self.registerObserver(notificationName, observer)
+	self.replaySticky(notificationName, observer)
+}
+
+/*
+registerObserver Register an IObserver without replaying a sticky INotification.
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) registerObserver(notificationName string, observer interfaces.IObserver) {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	self.observerMap[notificationName] = insertObserver(self.observerMap[notificationName], observer)
+}
+
+/*
+RegisterObserverKeyed Register an IObserver to be notified
+of INotifications with a given name, under a key.
+
+Registering again with the same name and key replaces the
+IObserver registered before, rather than adding a second one,
+whatever their notification contexts.
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter key: the key identifying the registration
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) RegisterObserverKeyed(notificationName string, key string, observer interfaces.IObserver) {
+	self.observerMapMutex.Lock()
+	observers, _ := removeKeyedObserver(self.observerMap[notificationName], key)
+	self.observerMap[notificationName] = insertObserver(observers, &keyedObserver{IObserver: observer, key: key})
+	self.observerMapMutex.Unlock()
+
+	self.replaySticky(notificationName, observer)
+}
+
+/*
+RemoveObserverKeyed Remove the IObserver registered under a key
+for a given Notification name.
+
+- parameter notificationName: which observer list to remove from
+
+- parameter key: the key given to RegisterObserverKeyed
+
+- returns: whether an IObserver was registered under the key and removed
+*/
+func (self *View) RemoveObserverKeyed(notificationName string, key string) bool {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	observers, removed := removeKeyedObserver(self.observerMap[notificationName], key)
+	if len(observers) == 0 {
+		delete(self.observerMap, notificationName)
+	} else {
+		self.observerMap[notificationName] = observers
+	}
+	return removed
+}
+
+/*
+RegisterObserverH Register an IObserver to be notified
+of INotifications with a given name, returning a handle
+that removes it.
+
+Calling the handle removes the IObserver as RemoveObserverInstance
+would; calling it again does nothing.
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter observer: the IObserver to register
+
+- returns: a func that removes the IObserver
+*/
+func (self *View) RegisterObserverH(notificationName string, observer interfaces.IObserver) func() {
+	self.RegisterObserver(notificationName, observer)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { self.RemoveObserverInstance(notificationName, observer) })
+	}
+}
+
+/*
+RegisterObserverCtx Register an IObserver to be notified
+of INotifications with a given name until a context is done.
+
+A goroutine waits for the context to be done, then removes
+the IObserver as RemoveObserverInstance would, so each
+registration costs one goroutine for as long as the context
+lives. Use a context that is eventually cancelled.
+
+- parameter ctx: the context bounding the registration
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) RegisterObserverCtx(ctx context.Context, notificationName string, observer interfaces.IObserver) {
+	remove := self.RegisterObserverH(notificationName, observer)
+	go func() {
+		<-ctx.Done()
+		remove()
+	}()
+}
+
+/*
+RetainObserver Register an IObserver to be notified of INotifications
+with a given name, counting the subscriptions to it.
+
+The first call for a notifyContext registers the IObserver;
+later calls for the same notifyContext only count another
+subscription and ignore the IObserver passed. The IObserver
+stays registered until ReleaseObserver was called as many
+times as RetainObserver, or until RemoveObserver removes it.
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) RetainObserver(notificationName string, observer interfaces.IObserver) {
+	self.observerMapMutex.Lock()
+	if _, retained := findRetainedObserver(self.observerMap[notificationName], observer.NotifyContext()); retained != nil {
+		retained.count++
+		self.observerMapMutex.Unlock()
+		return
+	}
+	self.observerMap[notificationName] = insertObserver(self.observerMap[notificationName], &retainedObserver{IObserver: observer, count: 1})
+	self.observerMapMutex.Unlock()
+
+	self.replaySticky(notificationName, observer)
+}
+
+/*
+ReleaseObserver Release a subscription taken with RetainObserver,
+removing the IObserver once none is left.
+
+- parameter notificationName: the name of the INotifications the IObserver is registered for
+
+- parameter notifyContext: the notifyContext of the retained IObserver
+*/
+func (self *View) ReleaseObserver(notificationName string, notifyContext interface{}) {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	observers := self.observerMap[notificationName]
+	index, retained := findRetainedObserver(observers, notifyContext)
+	if retained == nil {
+		return
+	}
+	retained.count--
+	if retained.count > 0 {
+		return
+	}
+
+	observers = append(observers[:index:index], observers[index+1:]...)
+	if len(observers) == 0 {
+		delete(self.observerMap, notificationName)
+	} else {
+		self.observerMap[notificationName] = observers
+	}
+}
+
+/*
+RegisterObserverWeak Register an IObserver to be notified
+of INotifications with a given name until its notify context
+is disposed.
+
+The notify context of the IObserver must implement IDisposed;
+otherwise the IObserver is not registered, and the misuse is
+logged, or panics in debug mode. Once the context reports
+that it is disposed, the IObserver is no longer notified,
+and SweepObservers removes it.
+
+Go has no weak references, so this is not one: the View holds
+the IObserver, and through it its notify context, until the
+next SweepObservers after the disposal. The context must
+report its own disposal, and a long-running application
+should call SweepObservers periodically, for instance from
+a time.Ticker.
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) RegisterObserverWeak(notificationName string, observer interfaces.IObserver) {
+	disposable, ok := observer.NotifyContext().(interfaces.IDisposed)
+	if !ok {
+		debug.Report("cannot register an observer weakly for notification %q: its notify context %T does not implement IDisposed", notificationName, observer.NotifyContext())
+		return
+	}
+	self.RegisterObserver(notificationName, &weakObserver{IObserver: observer, context: disposable})
+}
+
+/*
+SweepObservers Remove the IObservers registered with RegisterObserverWeak
+whose notify context is disposed.
+
+- returns: the number of IObservers removed
+*/
+func (self *View) SweepObservers() int {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	var swept = 0
+	for notificationName, observers := range self.observerMap {
+		var kept []interfaces.IObserver
+		for _, observer := range observers {
+			if isDisposedObserver(observer) {
+				swept++
+			} else {
+				kept = append(kept, observer)
+			}
+		}
+		if len(kept) == len(observers) {
+			continue
+		}
+		if len(kept) == 0 {
+			delete(self.observerMap, notificationName)
+		} else {
+			self.observerMap[notificationName] = kept
+		}
+	}
+	return swept
+}
+
+/*
+SetObserverPriority Change the priority of the observer for a given notifyContext
+in the observer list for a given Notification name.
+
+IObservers of a higher priority are notified first; IObservers
+have priority 0 until changed. The observer moves behind the
+other IObservers of its new priority.
+
+- parameter notificationName: which observer list to reorder
+
+- parameter notifyContext: reprioritize the observer with this object as its notifyContext
+
+- parameter priority: the new priority of the observer
+*/
+func (self *View) SetObserverPriority(notificationName string, notifyContext interface{}, priority int) {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	observers := self.observerMap[notificationName]
+	for index, observer := range observers {
+		if observer.CompareNotifyContext(notifyContext) {
+			if prioritized, ok := observer.(*priorityObserver); ok {
+				observer = prioritized.IObserver
+			}
+			observers = append(observers[:index:index], observers[index+1:]...)
+			self.observerMap[notificationName] = insertObserver(observers, &priorityObserver{IObserver: observer, priority: priority})
+			return
+		}
+	}
+}
+
+/*
+RegisterObserverForType Register an IObserver to be notified
+of INotifications with a given name and type.
+
+INotifications with the same name but a different type
+are not delivered to this IObserver, while IObservers
+registered with RegisterObserver still receive every type.
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter notificationType: the type of the INotifications to notify this IObserver of
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) RegisterObserverForType(notificationName string, notificationType string, observer interfaces.IObserver) {
+	self.RegisterObserverFiltered(notificationName, observer, func(notification interfaces.INotification) bool {
+		return notification.Type() == notificationType
+	})
+}
+
+/*
+RegisterObserverFiltered Register an IObserver to be notified
+of INotifications with a given name that satisfy a predicate.
+
+INotifications with the name for which the predicate returns
+false are not delivered to this IObserver, while IObservers
+registered with RegisterObserver still receive all of them.
+The predicate is called outside of the observer map lock.
+
+- parameter notificationName: the name of the INotifications to notify this IObserver of
+
+- parameter observer: the IObserver to register
+
+- parameter predicate: the func deciding whether to notify the IObserver of an INotification
+*/
+func (self *View) RegisterObserverFiltered(notificationName string, observer interfaces.IObserver, predicate func(notification interfaces.INotification) bool) {
+	self.RegisterObserver(notificationName, &filterObserver{IObserver: observer, accept: predicate})
+}
+
+/*
+RegisterPrefixObserver Register an IObserver to be notified
+of INotifications whose name begins with a given prefix.
+
+- parameter prefix: the prefix of the INotification names to notify this IObserver of
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) RegisterPrefixObserver(prefix string, observer interfaces.IObserver) {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	self.prefixObserverMap[prefix] = append(self.prefixObserverMap[prefix], observer)
+}
+
+/*
+RegisterGlobalObserver Register an IObserver to be notified
+of every INotification, whatever its name.
+
+A global IObserver is an IObserver for the empty prefix, so
+it is notified after the IObservers registered for the
+INotification's name and for any longer prefix of it.
+
+- parameter observer: the IObserver to register
+*/
+func (self *View) RegisterGlobalObserver(observer interfaces.IObserver) {
+	self.RegisterPrefixObserver("", observer)
+}
+
+/*
+NotifyObservers Notify the IObservers for a particular INotification.
+
+All previously attached IObservers for this INotification's
+list are notified and are passed a reference to the INotification in
+the order in which they were registered.
+
+IObservers registered for a prefix of the INotification's name
+are notified afterwards, longest prefix first.
+
+The IObservers are copied to a working array, so that they
+may be registered or removed during the notification; working
+arrays are reused across INotifications to spare allocations.
+
+While dispatch metrics are enabled, the time spent notifying
+the IObservers is added to the metrics of the INotification's name.
+
+- parameter notification: the INotification to notify IObservers of.
+*/
+func (self *View) NotifyObservers(notification interfaces.INotification) {
+	self.cacheSticky(notification)
+
+	pooled := getObservers()
+	observers := self.appendObservers(*pooled, notification)
+
+	var start time.Time
+	var measured = self.dispatchMetrics.Load()
+	if measured {
+		start = time.Now()
+	}
+
+	// Notify Observers from the working array
+	for _, observer := range observers {
+		observer.NotifyObserver(notification)
+	}
+	putObservers(pooled, observers)
+
+	if measured {
+		self.recordDispatch(notification.Name(), time.Since(start))
+	}
+}
+
+/*
+recordDispatch Add a dispatch to the metrics of an INotification name.
+
+- parameter notificationName: the name of the dispatched INotification
+
+- parameter duration: the time spent notifying its IObservers
+*/
+func (self *View) recordDispatch(notificationName string, duration time.Duration) {
+	self.dispatchMetricsMutex.Lock()
+	defer self.dispatchMetricsMutex.Unlock()
+
+	// metrics may have been disabled during the dispatch
+	if self.dispatchMetricsMap == nil {
+		return
+	}
+	metric := self.dispatchMetricsMap[notificationName]
+	metric.Count++
+	metric.Total += duration
+	self.dispatchMetricsMap[notificationName] = metric
+}
+
+/*
+EnableDispatchMetrics Turn the measurement of NotifyObservers on or off.
+
+While on, each NotifyObservers call is counted and timed per
+INotification name, outside of the observer map lock. Turning
+it off discards the metrics.
+
+- parameter enabled: whether to measure dispatches
+*/
+func (self *View) EnableDispatchMetrics(enabled bool) {
+	self.dispatchMetricsMutex.Lock()
+	defer self.dispatchMetricsMutex.Unlock()
+
+	if enabled && self.dispatchMetricsMap == nil {
+		self.dispatchMetricsMap = map[string]DispatchMetric{}
+	} else if !enabled {
+		self.dispatchMetricsMap = nil
+	}
+	self.dispatchMetrics.Store(enabled)
+}
+
+/*
+DispatchMetrics Get the dispatch metrics gathered since they were enabled.
+
+- returns: a copy of the mapping of INotification names to their DispatchMetric
+*/
+func (self *View) DispatchMetrics() map[string]DispatchMetric {
+	self.dispatchMetricsMutex.Lock()
+	defer self.dispatchMetricsMutex.Unlock()
+
+	metrics := make(map[string]DispatchMetric, len(self.dispatchMetricsMap))
+	for name, metric := range self.dispatchMetricsMap {
+		metrics[name] = metric
+	}
+	return metrics
+}
+
+/*
+EnableStickyNotification Make INotifications of the given name sticky.
+
+The View keeps the last INotification sent with a sticky name
+and notifies any IObserver or IMediator that registers for the
+name afterwards of it right away, so late subscribers need not
+wait for the next one to be sent. Commands are not replayed:
+an IObserver whose notify context is an IController is skipped.
+
+The cached INotification, and whatever its body refers to, is
+kept in memory until the next one of the same name replaces it
+or DisableStickyNotification is called. Avoid making names with
+large or short-lived bodies sticky.
+
+- parameter notificationName: the name of the INotifications to keep
+*/
+func (self *View) EnableStickyNotification(notificationName string) {
+	self.stickyMutex.Lock()
+	defer self.stickyMutex.Unlock()
+
+	if self.stickyNotifications == nil {
+		self.stickyNotifications = map[string]interfaces.INotification{}
+	}
+	if _, ok := self.stickyNotifications[notificationName]; !ok {
+		self.stickyNotifications[notificationName] = nil
+	}
+	self.sticky.Store(true)
+}
+
+/*
+DisableStickyNotification Stop keeping INotifications of the given name,
+releasing the last one kept.
+
+- parameter notificationName: the name of the INotifications to stop keeping
+*/
+func (self *View) DisableStickyNotification(notificationName string) {
+	self.stickyMutex.Lock()
+	defer self.stickyMutex.Unlock()
+
+	delete(self.stickyNotifications, notificationName)
+	self.sticky.Store(len(self.stickyNotifications) > 0)
+}
+
+/*
+cacheSticky Keep the INotification if its name is sticky.
+
+- parameter notification: the INotification being sent
+*/
+func (self *View) cacheSticky(notification interfaces.INotification) {
+	if !self.sticky.Load() {
+		return
+	}
+	self.stickyMutex.Lock()
+	defer self.stickyMutex.Unlock()
+
+	if _, ok := self.stickyNotifications[notification.Name()]; ok {
+		self.stickyNotifications[notification.Name()] = notification
+	}
+}
+
+/*
+stickyNotification Retrieve the last INotification kept for a sticky name.
+
+- parameter notificationName: the name of the INotification
+
+- returns: the last INotification sent with the name, or nil if there is none
+*/
+func (self *View) stickyNotification(notificationName string) interfaces.INotification {
+	self.stickyMutex.RLock()
+	defer self.stickyMutex.RUnlock()
+
+	return self.stickyNotifications[notificationName]
+}
+
+/*
+replaySticky Notify a newly registered IObserver of the last
+INotification kept for a sticky name.
+
+Called once the observer map lock is released, since the
+IObserver may register or remove IObservers itself.
+
+- parameter notificationName: the name the IObserver was registered for
+
+- parameter observer: the newly registered IObserver
+*/
+func (self *View) replaySticky(notificationName string, observer interfaces.IObserver) {
+	if !self.sticky.Load() {
+		return
+	}
+	// commands are registered under the controller's lock and must not be replayed
+	if _, ok := observer.NotifyContext().(interfaces.IController); ok {
+		return
+	}
+	if notification := self.stickyNotification(notificationName); notification != nil {
+		observer.NotifyObserver(notification)
+	}
+}
+
+/*
+NotifyObserversIsolated Notify the IObservers for a particular INotification,
+giving each IObserver its own copy of the INotification's body.
+
+If the body implements ICloneable, each IObserver is passed an
+INotification with the same name and type and a Clone of the
+body, so that mutations by one IObserver are not seen by the
+next. Any other body is passed as NotifyObservers would pass
+it: a value is copied along with the INotification, but what
+a pointer, slice or map refers to is shared.
+
+- parameter notification: the INotification to notify IObservers of.
+*/
+func (self *View) NotifyObserversIsolated(notification interfaces.INotification) {
+	self.cacheSticky(notification)
+
+	cloneable, ok := notification.Body().(interfaces.ICloneable)
+	for _, o := range self.observersFor(notification) {
+		if ok {
+			o.NotifyObserver(observer.NewNotification(notification.Name(), cloneable.Clone(), notification.Type()))
+		} else {
+			o.NotifyObserver(notification)
+		}
+	}
+}
+
+/*
+QueryObservers Query the IObservers for a particular INotification.
+
+Each IObserver whose notification context implements
+IQueryObserver has its Respond called, in notification order,
+instead of being notified. Other IObservers are skipped.
+
+- parameter notification: the INotification carrying the query
+
+- returns: the results of the IQueryObservers, in notification order
+*/
+func (self *View) QueryObservers(notification interfaces.INotification) []interface{} {
+	var results []interface{}
+	for _, observer := range self.observersFor(notification) {
+		if respondent, ok := observer.NotifyContext().(interfaces.IQueryObserver); ok {
+			results = append(results, respondent.Respond(notification))
+		}
+	}
+	return results
+}
+
+/*
+HasObservers Check if any IObserver would be notified of
+INotifications with a particular name.
+
+IObservers registered for a prefix of the name, including
+global IObservers, count.
+
+- parameter notificationName: the name of the INotification
+
+- returns: whether an IObserver is registered for the name or a prefix of it
+*/
+func (self *View) HasObservers(notificationName string) bool {
+	self.observerMapMutex.RLock()
+	defer self.observerMapMutex.RUnlock()
+
+	if len(self.observerMap[notificationName]) > 0 {
+		return true
+	}
+	for prefix := range self.prefixObserverMap {
+		if strings.HasPrefix(notificationName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+observersFor Copy the IObservers to notify of a particular INotification.
+
+- parameter notification: the INotification to notify IObservers of
+
+- returns: a working array of the IObservers, in notification order
+*/
+func (self *View) observersFor(notification interfaces.INotification) []interfaces.IObserver {
+	return self.appendObservers(nil, notification)
+}
+
+/*
+appendObservers Copy the IObservers to notify of a particular INotification
+to the end of a working array.
+
+- parameter observers: the working array
+
+- parameter notification: the INotification to notify IObservers of
+
+- returns: the extended working array, in notification order
+*/
+func (self *View) appendObservers(observers []interfaces.IObserver, notification interfaces.INotification) []interfaces.IObserver {
+	self.observerMapMutex.RLock()
+	defer self.observerMapMutex.RUnlock()
+
+	// Copy observers from reference array to working array,
+	// since the reference array may change during the notification loop
+	observers = append(observers, self.observerMap[notification.Name()]...)
+
+	// Append the observers of every matching prefix, longest prefix first
+	for _, prefix := range self.matchingPrefixes(notification.Name()) {
+		observers = append(observers, self.prefixObserverMap[prefix]...)
+	}
+	return observers
+}
+
+/*
+matchingPrefixes Find the prefixes with IObservers that match an INotification name.
+
+The caller must hold the observer map lock.
+
+- parameter notificationName: the name of the INotification
+
+- returns: the matching prefixes, longest first, or nil if there are none
+*/
+func (self *View) matchingPrefixes(notificationName string) []string {
+	var prefixes []string
+	for prefix := range self.prefixObserverMap {
+		if strings.HasPrefix(notificationName, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) > len(prefixes[j]) })
+	return prefixes
+}
+
+/*
+NotifyObserversNoCopy Notify the IObservers for a particular INotification
+without copying their list first.
+
+The IObservers are notified in the same order as with
+NotifyObservers, but straight from the View's own observer
+lists, which saves copying them on every INotification.
+
+DANGER: this is only safe if no IObserver is registered or
+removed for the INotification's name, or for a matching prefix,
+until the notification completes, whether by one of the
+IObservers or by another goroutine. Removing an IObserver
+shifts the list in place, so an IObserver may be skipped or
+notified twice, and a concurrent change is a data race.
+This includes registering or removing IMediators and ICommands
+interested in the INotification. When in doubt, use NotifyObservers.
+
+- parameter notification: the INotification to notify IObservers of.
+*/
+func (self *View) NotifyObserversNoCopy(notification interfaces.INotification) {
+	self.cacheSticky(notification)
+
+	self.observerMapMutex.RLock()
+	var observers = self.observerMap[notification.Name()]
+	var prefixed [][]interfaces.IObserver
+	for _, prefix := range self.matchingPrefixes(notification.Name()) {
+		prefixed = append(prefixed, self.prefixObserverMap[prefix])
+	}
+	self.observerMapMutex.RUnlock()
+
+	for _, observer := range observers {
+		observer.NotifyObserver(notification)
+	}
+	for _, observers := range prefixed {
+		for _, observer := range observers {
+			observer.NotifyObserver(notification)
+		}
+	}
+}
+
+/*
+NotifyObserversBatch Notify the IObservers for several INotifications.
+
+The INotifications are dispatched in order, each one as
+NotifyObservers would, so that IObservers registered or
+removed while handling one INotification are taken into
+account for the next.
+
+- parameter notifications: the INotifications to notify IObservers of.
+*/
+func (self *View) NotifyObserversBatch(notifications []interfaces.INotification) {
+	for _, notification := range notifications {
+		self.NotifyObservers(notification)
+	}
+}
+
+/*
+RemoveObserver Remove the observer for a given notifyContext from an observer list for a given Notification name.
+
+- parameter notificationName: which observer list to remove from
+
+- parameter notifyContext: remove the observer with this object as its notifyContext
+*/
+func (self *View) RemoveObserver(notificationName string, notifyContext interface{}) {
+	self.RemoveObserverB(notificationName, notifyContext)
+}
+
+/*
+RemoveObserverB Remove the observer for a given notifyContext from an observer list for a given Notification name,
+reporting whether there was one.
+
+- parameter notificationName: which observer list to remove from
+
+- parameter notifyContext: remove the observer with this object as its notifyContext
+
+- returns: whether an observer was removed
+*/
+func (self *View) RemoveObserverB(notificationName string, notifyContext interface{}) bool {
 	self.observerMapMutex.Lock()
 	defer self.observerMapMutex.Unlock()
 
-	if self.observerMap[notificationName] != nil {
-		self.observerMap[notificationName] = append(self.observerMap[notificationName], observer)
+	// the observer list for the notification under inspection
+	observers := self.observerMap[notificationName]
+	removed := false
+
+	// find the observer for the notifyContext
+	for index, observer := range observers {
+		if observer.CompareNotifyContext(notifyContext) == true {
+			// there can only be one Observer for a given notifyContext
+			// in any given Observer list, so remove it and break
+			observers = append(observers[:index], observers[index+1:]...)
+			removed = true
+			break
+		}
+	}
+
+	// Also, when a Notification's Observer list length falls to
+	// zero, delete the notification key from the observer map
+	if len(observers) == 0 {
+		delete(self.observerMap, notificationName)
+	} else {
+		self.observerMap[notificationName] = observers
+	}
+	return removed
+}
+
+/*
+RemoveObserverInstance Remove a given observer from an observer list for a given Notification name.
+
+Unlike RemoveObserver, which matches the first observer with a
+given notifyContext, this matches the registered IObserver that
+Equals the given one, once the View's own decorators are unwrapped,
+so one of several observers sharing a notifyContext can be removed.
+
+- parameter notificationName: which observer list to remove from
+
+- parameter observer: the IObserver instance to remove
+*/
+func (self *View) RemoveObserverInstance(notificationName string, observer interfaces.IObserver) {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	observers := self.observerMap[notificationName]
+	for index, registered := range observers {
+		if unwrapObserver(registered).Equals(observer) {
+			observers = append(observers[:index], observers[index+1:]...)
+			break
+		}
+	}
+
+	if len(observers) == 0 {
+		delete(self.observerMap, notificationName)
 	} else {
-		self.observerMap[notificationName] = []interfaces.IObserver{observer}
+		self.observerMap[notificationName] = observers
 	}
 }
 
 /*
-NotifyObservers Notify the IObservers for a particular INotification.
+unwrapObserver Get the IObserver registered by the caller from an
+IObserver in an observer list, stripping the View's decorators.
 
-All previously attached IObservers for this INotification's
-list are notified and are passed a reference to the INotification in
-the order in which they were registered.
+- parameter observer: an IObserver from an observer list
 
-- parameter notification: the INotification to notify IObservers of.
+- returns: the IObserver that was passed to the View
 */
-func (self *View) NotifyObservers(notification interfaces.INotification) {
-	self.observerMapMutex.RLock()
-
-	var observers []interfaces.IObserver
-	if self.observerMap[notification.Name()] != nil {
-		// Get a reference to the observers list for this notification name
-		observersRef := self.observerMap[notification.Name()]
-
-		// Copy observers from reference array to working array,
-		// since the reference array may change during the notification loop
-		observers = make([]interfaces.IObserver, len(observersRef))
-		copy(observers, observersRef)
-	}
-
-	self.observerMapMutex.RUnlock()
-
-	// Notify Observers from the working array
-	for _, observer := range observers {
-		observer.NotifyObserver(notification)
+func unwrapObserver(observer interfaces.IObserver) interfaces.IObserver {
+	for {
+		switch decorated := observer.(type) {
+		case *priorityObserver:
+			observer = decorated.IObserver
+		case *keyedObserver:
+			observer = decorated.IObserver
+		case *filterObserver:
+			observer = decorated.IObserver
+		case *weakObserver:
+			observer = decorated.IObserver
+		case *retainedObserver:
+			observer = decorated.IObserver
+		default:
+			return observer
+		}
 	}
 }
 
 /*
-RemoveObserver Remove the observer for a given notifyContext from an observer list for a given Notification name.
+RemovePrefixObserver Remove the observer for a given notifyContext from an observer list for a given prefix.
 
-- parameter notificationName: which observer list to remove from
+- parameter prefix: which prefix observer list to remove from
 
 - parameter notifyContext: remove the observer with this object as its notifyContext
 */
-func (self *View) RemoveObserver(notificationName string, notifyContext interface{}) {
+func (self *View) RemovePrefixObserver(prefix string, notifyContext interface{}) {
 	self.observerMapMutex.Lock()
 	defer self.observerMapMutex.Unlock()
 
-	// the observer list for the notification under inspection
-	observers := self.observerMap[notificationName]
-
-	// find the observer for the notifyContext
+	observers := self.prefixObserverMap[prefix]
 	for index, observer := range observers {
 		if observer.CompareNotifyContext(notifyContext) == true {
-			// there can only be one Observer for a given notifyContext
-			// in any given Observer list, so remove it and break
 			observers = append(observers[:index], observers[index+1:]...)
 			break
 		}
 	}
 
-	// Also, when a Notification's Observer list length falls to
-	// zero, delete the notification key from the observer map
 	if len(observers) == 0 {
-		delete(self.observerMap, notificationName)
+		delete(self.prefixObserverMap, prefix)
+	} else {
+		self.prefixObserverMap[prefix] = observers
+	}
+}
+
+/*
+RemoveGlobalObserver Remove the global observer for a given notifyContext.
+
+- parameter notifyContext: remove the observer with this object as its notifyContext
+*/
+func (self *View) RemoveGlobalObserver(notifyContext interface{}) {
+	self.RemovePrefixObserver("", notifyContext)
+}
+
+/*
+ListObserverPrefixes List the prefixes that have prefix observers.
+
+Global observers are listed under the empty prefix.
+
+- returns: the prefixes, sorted
+*/
+func (self *View) ListObserverPrefixes() []string {
+	self.observerMapMutex.RLock()
+	defer self.observerMapMutex.RUnlock()
+
+	prefixes := make([]string, 0, len(self.prefixObserverMap))
+	for prefix := range self.prefixObserverMap {
+		prefixes = append(prefixes, prefix)
 	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+/*
+RemovePrefixObservers Remove every observer registered for a given prefix.
+
+- parameter prefix: which prefix observer list to remove, or "" for the global observers
+*/
+func (self *View) RemovePrefixObservers(prefix string) {
+	self.observerMapMutex.Lock()
+	defer self.observerMapMutex.Unlock()
+
+	delete(self.prefixObserverMap, prefix)
 }
 
 /*
@@ -167,24 +1097,117 @@ the IMediator instance's handleNotification method
 and registering it as an Observer for all INotifications the
 IMediator is interested in.
 
+If the IMediator's view component is an IDisposableComponent,
+the IMediator is removed when the component is disposed, unless
+another IMediator has been registered under its name by then.
+
+OnRegister is called after the mediator map lock is released,
+so that it may itself register or remove IMediator instances.
+
+An IMediator with an empty name is not registered: the misuse
+is logged, or panics in debug mode.
+
 - parameter mediator: a reference to the IMediator instance
 */
 func (self *View) RegisterMediator(mediator interfaces.IMediator) {
+	self.registerMediator(mediator, "")
+}
+
+/*
+RegisterMediatorInGroup Register an IMediator instance with the View
+as a member of a named group.
+
+The IMediator is registered as with RegisterMediator, and
+is also reached by NotifyGroup for the group. An IMediator
+belongs to the group it was registered in until it is removed.
+
+- parameter mediator: a reference to the IMediator instance
+
+- parameter group: the name of the group
+*/
+func (self *View) RegisterMediatorInGroup(mediator interfaces.IMediator, group string) {
+	self.registerMediator(mediator, group)
+}
+
+/*
+registerMediator Register an IMediator instance with the View,
+in a group unless the group is empty.
+
+- parameter mediator: a reference to the IMediator instance
+
+- parameter group: the name of the group, or ""
+*/
+func (self *View) registerMediator(mediator interfaces.IMediator, group string) {
+	if mediator.GetMediatorName() == "" {
+		debug.Report("cannot register a mediator of type %T with an empty name", mediator)
+		return
+	}
+
 	self.mediatorMapMutex.Lock()
-	defer self.mediatorMapMutex.Unlock()
 
 	// do not allow re-registration (you must removeMediator fist)
 	if self.mediatorMap[mediator.GetMediatorName()] != nil {
+		self.mediatorMapMutex.Unlock()
 		return
 	}
 
-	mediator.InitializeNotifier()
+	self.storeMediator(mediator)
+	if group != "" {
+		self.mediatorGroups[mediator.GetMediatorName()] = group
+	}
+	self.mediatorMapMutex.Unlock()
+
+	self.mediatorStored(mediator)
+}
+
+/*
+NotifyGroup Notify the IMediators of a group that are
+interested in a particular INotification.
+
+IObservers other than the group's IMediators are not notified.
+
+- parameter group: the name of the group
+
+- parameter notification: the INotification to notify the IMediators of.
+*/
+func (self *View) NotifyGroup(group string, notification interfaces.INotification) {
+	self.mediatorMapMutex.RLock()
+	var members []interfaces.IMediator
+	for name, memberGroup := range self.mediatorGroups {
+		if memberGroup == group {
+			members = append(members, self.mediatorMap[name])
+		}
+	}
+	self.mediatorMapMutex.RUnlock()
+
+	for _, observer := range self.observersFor(notification) {
+		for _, member := range members {
+			if observer.CompareNotifyContext(member) {
+				observer.NotifyObserver(notification)
+				break
+			}
+		}
+	}
+}
+
+/*
+storeMediator Store an IMediator and register its Observers.
+
+The caller must hold the mediator map lock.
+
+- parameter mediator: a reference to the IMediator instance
+*/
+func (self *View) storeMediator(mediator interfaces.IMediator) {
+	self.initializeNotifier(mediator)
 
 	// Register the Mediator for retrieval by name
 	self.mediatorMap[mediator.GetMediatorName()] = mediator
+	self.mediatorSequence++
+	self.mediatorOrder[mediator.GetMediatorName()] = self.mediatorSequence
 
-	// Get Notification interests, if any.
+	// Get Notification interests, if any, and keep them for RemoveMediator
 	interests := mediator.ListNotificationInterests()
+	self.mediatorInterests[mediator.GetMediatorName()] = append([]string(nil), interests...)
 
 	// Register Mediator as an observer for each notification of interests
 	if len(interests) > 0 {
@@ -193,12 +1216,167 @@ func (self *View) RegisterMediator(mediator interfaces.IMediator) {
 
 		// Register Mediator as Observer for its list of Notification interests
 		for _, interest := range interests {
-			self.RegisterObserver(interest, observer)
+			self.registerObserver(interest, observer)
+		}
+
+	}
+}
+
+/*
+RegisterMediatorsStrict Register several IMediator instances with the View,
+all of them or none.
+
+The names are checked under the mediator map lock before any
+IMediator is stored: if one is empty, already registered, or
+used twice in the set, nothing is registered and an error
+naming it is returned. Otherwise every IMediator is registered
+as with RegisterMediator, and their OnRegister are called in
+order once the lock is released.
+
+- parameter mediators: the IMediator instances to register
+
+- returns: nil, or an error wrapping ErrMediatorExists for a name collision
+*/
+func (self *View) RegisterMediatorsStrict(mediators []interfaces.IMediator) error {
+	self.mediatorMapMutex.Lock()
+
+	var names = make(map[string]bool, len(mediators))
+	for _, mediator := range mediators {
+		var name = mediator.GetMediatorName()
+		if name == "" {
+			self.mediatorMapMutex.Unlock()
+			return fmt.Errorf("cannot register a mediator of type %T with an empty name", mediator)
+		}
+		if self.mediatorMap[name] != nil || names[name] {
+			self.mediatorMapMutex.Unlock()
+			return fmt.Errorf("%w: %q", ErrMediatorExists, name)
 		}
+		names[name] = true
+	}
+
+	for _, mediator := range mediators {
+		self.storeMediator(mediator)
+	}
+	self.mediatorMapMutex.Unlock()
+
+	for _, mediator := range mediators {
+		self.mediatorStored(mediator)
+	}
+	return nil
+}
+
+/*
+mediatorStored Finish the registration of a stored IMediator,
+once the mediator map lock is released.
 
+- parameter mediator: a reference to the IMediator instance
+*/
+func (self *View) mediatorStored(mediator interfaces.IMediator) {
+	// remove the mediator along with its view component
+	if component, ok := mediator.GetViewComponent().(interfaces.IDisposableComponent); ok {
+		component.OnDispose(func() {
+			if self.RetrieveMediator(mediator.GetMediatorName()) == mediator {
+				self.RemoveMediator(mediator.GetMediatorName())
+			}
+		})
 	}
+
 	// alert the mediator that it has been registered
 	mediator.OnRegister()
+
+	// replay the sticky notifications it is interested in
+	if self.sticky.Load() {
+		self.mediatorMapMutex.RLock()
+		interests := self.mediatorInterests[mediator.GetMediatorName()]
+		self.mediatorMapMutex.RUnlock()
+
+		for _, interest := range interests {
+			if notification := self.stickyNotification(interest); notification != nil {
+				mediator.HandleNotification(notification)
+			}
+		}
+	}
+}
+
+/*
+RegisterMediatorReplace Register an IMediator instance with the View,
+replacing any IMediator already registered under the same name.
+
+Unlike RegisterMediator, which ignores an IMediator whose name
+is already registered, the existing IMediator is first removed,
+its observers unregistered and its OnRemove called.
+
+- parameter mediator: a reference to the IMediator instance
+*/
+func (self *View) RegisterMediatorReplace(mediator interfaces.IMediator) {
+	self.RemoveMediator(mediator.GetMediatorName())
+	self.RegisterMediator(mediator)
+}
+
+/*
+RetrieveMediatorOrRegister Retrieve an IMediator from the View,
+registering one created by the factory if there is none.
+
+The check and the registration happen under the mediator map
+lock, so concurrent callers all get the same IMediator and the
+factory is called at most once per registration. The factory
+must not use the View; if it panics, the lock is released.
+The IMediator's Observers are registered and its OnRegister
+called, as with RegisterMediator, only if it is created.
+
+The factory's IMediator must be non-nil and named mediatorName;
+otherwise it is not registered, nil is returned, and the misuse
+is logged, or panics in debug mode.
+
+- parameter mediatorName: the name of the IMediator
+
+- parameter factory: reference that returns the IMediator to register
+
+- returns: the IMediator registered under mediatorName
+*/
+func (self *View) RetrieveMediatorOrRegister(mediatorName string, factory func() interfaces.IMediator) interfaces.IMediator {
+	mediator, created := self.retrieveOrStoreMediator(mediatorName, factory)
+	if created {
+		self.mediatorStored(mediator)
+		return mediator
+	}
+	if mediator == nil {
+		debug.Report("cannot register a nil mediator as %q", mediatorName)
+		return nil
+	}
+	if mediatorName == "" || mediator.GetMediatorName() != mediatorName {
+		debug.Report("cannot register a mediator named %q as %q", mediator.GetMediatorName(), mediatorName)
+		return nil
+	}
+	return mediator
+}
+
+/*
+retrieveOrStoreMediator Retrieve an IMediator from the View, or store
+one created by the factory, under the mediator map lock.
+
+The lock is released even if the factory panics.
+
+- parameter mediatorName: the name of the IMediator
+
+- parameter factory: reference that returns the IMediator to register
+
+- returns: the registered IMediator, or the factory's IMediator if it was
+not stored, and whether the factory's IMediator was stored
+*/
+func (self *View) retrieveOrStoreMediator(mediatorName string, factory func() interfaces.IMediator) (interfaces.IMediator, bool) {
+	self.mediatorMapMutex.Lock()
+	defer self.mediatorMapMutex.Unlock()
+
+	if existing := self.mediatorMap[mediatorName]; existing != nil {
+		return existing, false
+	}
+	var mediator = factory()
+	if mediator == nil || mediatorName == "" || mediator.GetMediatorName() != mediatorName {
+		return mediator, false
+	}
+	self.storeMediator(mediator)
+	return mediator, true
 }
 
 /*
@@ -215,24 +1393,178 @@ func (self *View) RetrieveMediator(mediatorName string) interfaces.IMediator {
 	return self.mediatorMap[mediatorName]
 }
 
+/*
+ListMediatorNames List the names of the registered IMediator instances.
+
+- returns: the IMediator names, sorted
+*/
+func (self *View) ListMediatorNames() []string {
+	self.mediatorMapMutex.RLock()
+	defer self.mediatorMapMutex.RUnlock()
+
+	names := make([]string, 0, len(self.mediatorMap))
+	for name := range self.mediatorMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+MediatorRegistrationOrder List the names of the registered IMediator
+instances in the order they were registered.
+
+An IMediator replaced with RegisterMediatorReplace, or removed
+and registered again, takes its place as a new registration.
+
+- returns: the names of the registered IMediator instances, earliest registration first
+*/
+func (self *View) MediatorRegistrationOrder() []string {
+	self.mediatorMapMutex.RLock()
+	defer self.mediatorMapMutex.RUnlock()
+
+	names := make([]string, 0, len(self.mediatorMap))
+	for name := range self.mediatorMap {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return self.mediatorOrder[names[i]] < self.mediatorOrder[names[j]]
+	})
+	return names
+}
+
+/*
+MediatorCount Count the registered IMediator instances.
+
+- returns: the number of registered IMediator instances
+*/
+func (self *View) MediatorCount() int {
+	self.mediatorMapMutex.RLock()
+	defer self.mediatorMapMutex.RUnlock()
+
+	return len(self.mediatorMap)
+}
+
+/*
+RetrieveAllMediators Retrieve every IMediator registered with the View.
+
+The returned slice is a snapshot that does not alias the View's
+own storage; registering or removing IMediator instances afterwards
+does not change it.
+
+- returns: the registered IMediator instances, ordered by name
+*/
+func (self *View) RetrieveAllMediators() []interfaces.IMediator {
+	self.mediatorMapMutex.RLock()
+	defer self.mediatorMapMutex.RUnlock()
+
+	names := make([]string, 0, len(self.mediatorMap))
+	for name := range self.mediatorMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mediators := make([]interfaces.IMediator, len(names))
+	for i, name := range names {
+		mediators[i] = self.mediatorMap[name]
+	}
+	return mediators
+}
+
+/*
+ObserverCounts Count the IObservers registered for each INotification name.
+
+- returns: a mapping of INotification names to the length of their observer lists
+*/
+func (self *View) ObserverCounts() map[string]int {
+	self.observerMapMutex.RLock()
+	defer self.observerMapMutex.RUnlock()
+
+	counts := make(map[string]int, len(self.observerMap))
+	for name, observers := range self.observerMap {
+		counts[name] = len(observers)
+	}
+	return counts
+}
+
+/*
+ObserverContexts List the notification contexts of the IObservers
+registered for a particular INotification name.
+
+- parameter notificationName: the name of the INotification
+
+- returns: the notification contexts, in notification order
+*/
+func (self *View) ObserverContexts(notificationName string) []interface{} {
+	self.observerMapMutex.RLock()
+	defer self.observerMapMutex.RUnlock()
+
+	observers := self.observerMap[notificationName]
+	contexts := make([]interface{}, len(observers))
+	for i, observer := range observers {
+		contexts[i] = observer.NotifyContext()
+	}
+	return contexts
+}
+
+/*
+MediatorInterests List the INotification names an IMediator
+is actually registered to observe.
+
+Unlike ListNotificationInterests, which an IMediator declares,
+this reports the observer lists holding an IObserver with the
+IMediator as its notification context.
+
+- parameter mediatorName: the name of the IMediator
+
+- returns: the INotification names, sorted, or nil if no such IMediator is registered
+*/
+func (self *View) MediatorInterests(mediatorName string) []string {
+	var mediator = self.RetrieveMediator(mediatorName)
+	if mediator == nil {
+		return nil
+	}
+
+	self.observerMapMutex.RLock()
+	defer self.observerMapMutex.RUnlock()
+
+	var names []string
+	for name, observers := range self.observerMap {
+		for _, observer := range observers {
+			if observer.CompareNotifyContext(mediator) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 /*
 RemoveMediator Remove an IMediator from the View.
 
+The observers are removed for the interests the IMediator was
+registered with, even if its ListNotificationInterests now
+returns different ones; such a change is a bug in the IMediator,
+and is logged, or panics in debug mode, once it is removed.
+
+OnRemove is called after the mediator map lock is released,
+so that it may itself register or remove IMediator instances.
+
 - parameter mediatorName: name of the IMediator instance to be removed.
 
 - returns: the IMediator that was removed from the View
 */
 func (self *View) RemoveMediator(mediatorName string) interfaces.IMediator {
 	self.mediatorMapMutex.Lock()
-	defer self.mediatorMapMutex.Unlock()
 
 	// Retrieve the named mediator
 	var mediator = self.mediatorMap[mediatorName]
+	var interests = self.mediatorInterests[mediatorName]
 
 	if mediator != nil {
-		// for every notification this mediator is interested in...
-		interests := mediator.ListNotificationInterests()
-
+		// for every notification this mediator was registered for...
 		for _, interest := range interests {
 			// remove the observer linking the mediator
 			// to the notification interest
@@ -241,6 +1573,16 @@ func (self *View) RemoveMediator(mediatorName string) interfaces.IMediator {
 
 		// remove the mediator from the map
 		delete(self.mediatorMap, mediatorName)
+		delete(self.mediatorGroups, mediatorName)
+		delete(self.mediatorOrder, mediatorName)
+		delete(self.mediatorInterests, mediatorName)
+	}
+	self.mediatorMapMutex.Unlock()
+
+	if mediator != nil {
+		if !sameInterests(interests, mediator.ListNotificationInterests()) {
+			debug.Report("the notification interests of mediator %q changed after it was registered", mediatorName)
+		}
 
 		// alert the mediator that it has been removed
 		mediator.OnRemove()
@@ -248,6 +1590,32 @@ func (self *View) RemoveMediator(mediatorName string) interfaces.IMediator {
 	return mediator
 }
 
+/*
+sameInterests Check whether two lists hold the same INotification names, in any order.
+
+- parameter registered: the interests an IMediator was registered with
+
+- parameter current: the interests the IMediator lists now
+
+- returns: whether the lists hold the same names
+*/
+func sameInterests(registered []string, current []string) bool {
+	if len(registered) != len(current) {
+		return false
+	}
+	var counts = make(map[string]int, len(registered))
+	for _, interest := range registered {
+		counts[interest]++
+	}
+	for _, interest := range current {
+		if counts[interest] == 0 {
+			return false
+		}
+		counts[interest]--
+	}
+	return true
+}
+
 /*
 HasMediator Check if a Mediator is registered or not
 
@@ -257,7 +1625,7 @@ HasMediator Check if a Mediator is registered or not
 */
 func (self *View) HasMediator(mediatorName string) bool {
 	self.mediatorMapMutex.RLock()
-	self.mediatorMapMutex.RUnlock()
+	defer self.mediatorMapMutex.RUnlock()
 
 	return self.mediatorMap[mediatorName] != nil
 }
@@ -0,0 +1,50 @@
+//
+//  WeakObserver.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+weakObserver An IObserver decorator registered with RegisterObserverWeak,
+which stops notifying the wrapped IObserver once its notify context
+is disposed.
+
+All other IObserver methods are delegated to the wrapped IObserver.
+*/
+type weakObserver struct {
+	interfaces.IObserver
+	context interfaces.IDisposed
+}
+
+/*
+NotifyObserver Notify the wrapped IObserver, unless its notify context is disposed.
+
+- parameter notification: the INotification to pass to the wrapped IObserver
+*/
+func (self *weakObserver) NotifyObserver(notification interfaces.INotification) {
+	if !self.context.Disposed() {
+		self.IObserver.NotifyObserver(notification)
+	}
+}
+
+/*
+isDisposedObserver Check whether an IObserver in an observer list
+was registered with RegisterObserverWeak and its notify context is disposed.
+
+- parameter observer: an IObserver from an observer list
+
+- returns: whether the IObserver can be swept
+*/
+func isDisposedObserver(observer interfaces.IObserver) bool {
+	if prioritized, ok := observer.(*priorityObserver); ok {
+		observer = prioritized.IObserver
+	}
+	weak, ok := observer.(*weakObserver)
+	return ok && weak.context.Disposed()
+}
@@ -0,0 +1,60 @@
+//
+//  Debug.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+/*
+Package debug holds the PureMVC debug mode.
+
+In debug mode, the framework verifies contracts that are
+too costly or too strict to check in production, and fails
+loudly when they are broken. Debug mode is off by default.
+*/
+package debug
+
+import (
+	"fmt"
+	"log"
+	"sync/atomic"
+)
+
+var enabled atomic.Bool // whether debug mode is on
+
+/*
+SetDebugMode Turn debug mode on or off.
+
+- parameter on: whether the framework runs in debug mode
+*/
+func SetDebugMode(on bool) {
+	enabled.Store(on)
+}
+
+/*
+IsDebugMode Check if debug mode is on.
+
+- returns: whether the framework runs in debug mode
+*/
+func IsDebugMode() bool {
+	return enabled.Load()
+}
+
+/*
+Report Report a misuse of the framework that it can recover from.
+
+In debug mode the misuse panics; otherwise it is logged, and
+the caller skips the offending operation.
+
+- parameter format: the fmt format of the message describing the misuse
+
+- parameter args: the arguments of the format
+*/
+func Report(format string, args ...interface{}) {
+	message := "puremvc: " + fmt.Sprintf(format, args...)
+	if IsDebugMode() {
+		panic(message)
+	}
+	log.Print(message)
+}
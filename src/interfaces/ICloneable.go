@@ -0,0 +1,26 @@
+//
+//  ICloneable.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package interfaces
+
+/*
+ICloneable The interface definition for an INotification body
+that can be copied.
+
+When the body of an INotification implements it, the View
+can give each IObserver its own copy of the body, so that
+an IObserver mutating the body does not affect the others.
+*/
+type ICloneable interface {
+	/*
+	  Copy the body.
+
+	  - returns: a copy that can be mutated independently of the original
+	*/
+	Clone() interface{}
+}
@@ -8,6 +8,8 @@
 
 package interfaces
 
+import "time"
+
 /*
 IController The interface definition for a PureMVC Controller.
 
@@ -38,6 +40,72 @@ type IController interface {
 	*/
 	RegisterCommand(notificationName string, factory func() ICommand)
 
+	/*
+	  Register a particular ICommand class as the handler
+	  for a particular INotification, reporting whether it replaces one.
+
+	  - parameter notificationName: the name of the INotification
+	  - parameter factory: reference that returns ICommand
+	  - returns: whether an ICommand mapping already existed
+	*/
+	ReplaceCommand(notificationName string, factory func() ICommand) (hadPrevious bool)
+
+	/*
+	  Register a particular ICommand class as the handler
+	  for the next INotification with a particular name only.
+
+	  - parameter notificationName: the name of the INotification
+	  - parameter factory: reference that returns ICommand
+	*/
+	RegisterCommandOnce(notificationName string, factory func() ICommand)
+
+	/*
+	  Register a particular ICommand class as the handler
+	  for a particular INotification, executed asynchronously.
+
+	  - parameter notificationName: the name of the INotification
+	  - parameter factory: reference that returns ICommand
+	*/
+	RegisterCommandAsync(notificationName string, factory func() ICommand)
+
+	/*
+	  Execute the asynchronous ICommands on a fixed number of goroutines.
+
+	  - parameter size: the number of workers, at least 1
+	*/
+	EnableWorkerPool(size int)
+
+	/*
+	  Shut down the worker pool, waiting for its ICommands to complete.
+	*/
+	Shutdown()
+
+	/*
+	  Recover from the panics of the ICommands executed by ExecuteCommand.
+
+	  - parameter enabled: whether panics of ICommands are recovered
+	*/
+	SetRecoverCommands(enabled bool)
+
+	/*
+	  Register a particular ICommand class as an additional
+	  handler for a particular INotification, executed after
+	  the ICommands already registered for it.
+
+	  - parameter notificationName: the name of the INotification
+	  - parameter factory: reference that returns ICommand
+	*/
+	RegisterAdditionalCommand(notificationName string, factory func() ICommand)
+
+	/*
+	  Register a particular ICommand class as the handler
+	  for every INotification whose name begins with a prefix.
+
+	  - parameter prefix: the prefix of the INotification names
+	  - parameter factory: reference that returns ICommand
+	*/
+	RegisterCommandPrefix(prefix string, factory func() ICommand)
+
 	/*
 	  Execute the ICommand previously registered as the
 	  handler for INotifications with the given notification name.
@@ -46,6 +114,25 @@ type IController interface {
 	*/
 	ExecuteCommand(notification INotification)
 
+	/*
+	  Execute an ICommand instance, registered or not, for an INotification.
+
+	  - parameter command: the ICommand to execute
+	  - parameter notification: the INotification to execute it with
+	*/
+	ExecuteCommandInstance(command ICommand, notification INotification)
+
+	/*
+	  Execute the ICommand previously registered as the
+	  handler for INotifications with the given notification name,
+	  giving up on waiting for it after a timeout.
+
+	  - parameter notification: the INotification to execute the associated ICommand for
+	  - parameter timeout: how long to wait for the ICommand to finish
+	  - returns: an error wrapping ErrCommandTimeout if the ICommand did not finish in time
+	*/
+	ExecuteCommandTimeout(notification INotification, timeout time.Duration) error
+
 	/*
 	  Remove a previously registered ICommand to INotification mapping.
 
@@ -53,6 +140,77 @@ type IController interface {
 	*/
 	RemoveCommand(notificationName string)
 
+	/*
+	  Remove a previously registered ICommand to INotification mapping,
+	  reporting whether there was one.
+
+	  - parameter notificationName: the name of the INotification to remove the ICommand mapping for
+	  - returns: whether an ICommand mapping was registered and removed
+	*/
+	RemoveCommandB(notificationName string) bool
+
+	/*
+	  Remove a previously registered ICommand to INotification name prefix mapping.
+
+	  - parameter prefix: the prefix of the INotification names to remove the ICommand mapping for
+	*/
+	RemoveCommandPrefix(prefix string)
+
+	/*
+	  List the INotification name prefixes that have an ICommand mapping.
+
+	  - returns: the prefixes registered with RegisterCommandPrefix, sorted
+	*/
+	ListCommandPrefixes() []string
+
+	/*
+	  List the names of the INotifications that have an ICommand mapping.
+
+	  - returns: the INotification names, sorted
+	*/
+	ListCommandNames() []string
+
+	/*
+	  Enable or disable the execution of the ICommands mapped to an INotification name.
+
+	  - parameter notificationName: the name of the INotification
+
+	  - parameter enabled: whether the ICommands should be executed
+	*/
+	SetCommandEnabled(notificationName string, enabled bool)
+
+	/*
+	  Count the INotification names that have an ICommand mapping.
+
+	  - returns: the number of mapped INotification names
+	*/
+	CommandCount() int
+
+	/*
+	  Capture the ICommand mappings of the Controller, along with the
+	  names executing asynchronously, disabled, or registered once.
+
+	  - returns: a copy of the ICommand mappings and their state
+	*/
+	SnapshotCommands() struct {
+		Commands map[string][]func() ICommand
+		Async    map[string]bool
+		Disabled map[string]bool
+		Once     map[string]bool
+	}
+
+	/*
+	  Reinstate ICommand mappings and their state captured by SnapshotCommands.
+
+	  - parameter snapshot: the ICommand mappings to reinstate
+	*/
+	RestoreCommands(snapshot struct {
+		Commands map[string][]func() ICommand
+		Async    map[string]bool
+		Disabled map[string]bool
+		Once     map[string]bool
+	})
+
 	/*
 	  Check if a Command is registered for a given Notification
 
@@ -60,4 +218,11 @@ type IController interface {
 	  - returns: whether a Command is currently registered for the given notificationName.
 	*/
 	HasCommand(notificationName string) bool
+
+	/*
+	  Set the IFacade that the INotifiers registered with this Controller are initialized with.
+
+	  - parameter facade: the IFacade owning this Controller
+	*/
+	SetNotifierFacade(facade IFacade)
 }
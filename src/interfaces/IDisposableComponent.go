@@ -0,0 +1,25 @@
+//
+//  IDisposableComponent.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package interfaces
+
+/*
+IDisposableComponent The interface definition for a view component
+that reports its disposal.
+
+When the view component of an IMediator implements it, the
+View removes the IMediator once the component is disposed.
+*/
+type IDisposableComponent interface {
+	/*
+	  Register a func to be called when the component is disposed.
+
+	  - parameter callback: the func to call on disposal
+	*/
+	OnDispose(callback func())
+}
@@ -0,0 +1,26 @@
+//
+//  IDisposed.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package interfaces
+
+/*
+IDisposed The interface definition for a notify context
+that reports whether it has been disposed.
+
+An IObserver registered with RegisterObserverWeak stops being
+notified once its notify context is disposed, and is removed
+by the next SweepObservers.
+*/
+type IDisposed interface {
+	/*
+	  Check whether the notify context has been disposed.
+
+	  - returns: whether the notify context has been disposed
+	*/
+	Disposed() bool
+}
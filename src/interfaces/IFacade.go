@@ -8,6 +8,8 @@
 
 package interfaces
 
+import "time"
+
 /*
 IFacade The interface definition for a PureMVC Facade.
 
@@ -31,6 +33,18 @@ type IFacade interface {
 	*/
 	InitializeFacade()
 
+	/*
+	  Prepare the Singleton Facade instance, before InitializeFacade.
+	*/
+	PreInitialize()
+
+	/*
+	  Complete the Singleton Facade instance, after InitializeFacade.
+
+	  The Model, Controller and View exist by then.
+	*/
+	PostInitialize()
+
 	/*
 	  Initialize the Controller.
 	*/
@@ -54,6 +68,22 @@ type IFacade interface {
 	*/
 	RegisterCommand(notificationName string, factory func() ICommand)
 
+	/*
+	  Register a func as the handler for a particular INotification.
+
+	  - parameter notificationName: the name of the INotification to associate the handler with.
+	  - parameter handler: the func handling the INotification
+	*/
+	RegisterCommandFunc(notificationName string, handler func(notification INotification))
+
+	/*
+	  Register a feature's ICommands, IProxies and IMediators, removing them all if the registration fails.
+
+	  - parameter fn: the func registering the feature
+	  - returns: the error returned by fn
+	*/
+	Transaction(fn func(tx IFacadeTx) error) error
+
 	/*
 	  Remove a previously registered ICommand to INotification mapping from the Controller.
 
@@ -61,6 +91,15 @@ type IFacade interface {
 	*/
 	RemoveCommand(notificationName string)
 
+	/*
+	  Remove a previously registered ICommand to INotification mapping from the Controller,
+	  reporting whether there was one.
+
+	  - parameter notificationName: the name of the INotification to remove the ICommand mapping for
+	  - returns: whether an ICommand mapping was registered and removed
+	*/
+	RemoveCommandB(notificationName string) bool
+
 	/*
 	  Check if a Command is registered for a given Notification
 
@@ -69,6 +108,13 @@ type IFacade interface {
 	*/
 	HasCommand(notificationName string) bool
 
+	/*
+	  Count the INotification names that have an ICommand mapping.
+
+	  - returns: the number of mapped INotification names
+	*/
+	CommandCount() int
+
 	/*
 	  Register an IProxy with the Model by name.
 
@@ -76,6 +122,14 @@ type IFacade interface {
 	*/
 	RegisterProxy(proxy IProxy)
 
+	/*
+	  Register an IProxy with the Model,
+	  replacing any IProxy already registered under the same name.
+
+	  - parameter proxy: the IProxy to be registered with the Model.
+	*/
+	RegisterProxyReplace(proxy IProxy)
+
 	/*
 	  Retrieve a IProxy from the Model by name.
 
@@ -84,6 +138,28 @@ type IFacade interface {
 	*/
 	RetrieveProxy(proxyName string) IProxy
 
+	/*
+	  Retrieve the data of a IProxy from the Model by name.
+
+	  - parameter proxyName: the name of the IProxy whose data is retrieved.
+	  - returns: the data of the IProxy, and whether a IProxy is registered by proxyName.
+	*/
+	RetrieveProxyData(proxyName string) (interface{}, bool)
+
+	/*
+	  Retrieve every IProxy registered with the Model.
+
+	  - returns: a snapshot of the registered IProxy instances, ordered by name
+	*/
+	RetrieveAllProxies() []IProxy
+
+	/*
+	  Count the IProxy instances registered with the Model.
+
+	  - returns: the number of registered IProxy instances
+	*/
+	ProxyCount() int
+
 	/*
 	  Remove an IProxy instance from the Model by name.
 
@@ -107,6 +183,14 @@ type IFacade interface {
 	*/
 	RegisterMediator(mediator IMediator)
 
+	/*
+	  Register an IMediator instance with the View,
+	  replacing any IMediator already registered under the same name.
+
+	  - parameter mediator: a reference to the IMediator instance
+	*/
+	RegisterMediatorReplace(mediator IMediator)
+
 	/*
 	  Retrieve an IMediator instance from the View.
 
@@ -115,6 +199,20 @@ type IFacade interface {
 	*/
 	RetrieveMediator(mediatorName string) IMediator
 
+	/*
+	  Retrieve every IMediator registered with the View.
+
+	  - returns: a snapshot of the registered IMediator instances, ordered by name
+	*/
+	RetrieveAllMediators() []IMediator
+
+	/*
+	  Count the IMediator instances registered with the View.
+
+	  - returns: the number of registered IMediator instances
+	*/
+	MediatorCount() int
+
 	/*
 	  Remove a IMediator instance from the View.
 
@@ -131,6 +229,87 @@ type IFacade interface {
 	*/
 	HasMediator(mediatorName string) bool
 
+	/*
+	  Remove every Command, Mediator and Proxy and reset the Core actors.
+	*/
+	Shutdown()
+
+	/*
+	  Describe the registered Commands, Proxies, Mediators and Observers.
+
+	  - returns: a human readable snapshot of the application's PureMVC state
+	*/
+	DumpState() string
+
+	/*
+	  Register a middleware wrapping the dispatch of every INotification.
+
+	  - parameter middleware: the func wrapping the rest of the dispatch
+	*/
+	Use(middleware func(notification INotification, next func()))
+
+	/*
+	  Create and send an INotification, and wait for its response.
+
+	  - parameter notificationName: the name of the notification to send
+	  - parameter body: the body of the notification (optional)
+	  - parameter _type: the type of the notification, before the correlation id
+	  - returns: a channel receiving the response INotification
+	*/
+	SendNotificationAwait(notificationName string, body interface{}, _type string) <-chan INotification
+
+	/*
+	  Create an INotification and send it once the dispatch in progress is done.
+
+	  - parameter notificationName: the name of the notification to send
+	  - parameter body: the body of the notification (optional)
+	  - parameter _type: the type of the notification
+	*/
+	SendNotificationDeferred(notificationName string, body interface{}, _type string)
+
+	/*
+	  Register a func rewriting the body of INotifications with a particular name before they are sent.
+
+	  - parameter notificationName: the name of the INotification
+	  - parameter fn: the func returning the body to send in place of the given one
+	*/
+	RegisterBodyTransformer(notificationName string, fn func(body interface{}) interface{})
+
+	/*
+	  Create an INotification and send it after a delay.
+
+	  - parameter notificationName: the name of the notification to send
+	  - parameter body: the body of the notification (optional)
+	  - parameter _type: the type of the notification
+	  - parameter delay: how long to wait before sending
+	  - returns: a func cancelling the INotification, reporting whether it was still pending
+	*/
+	SendNotificationAfter(notificationName string, body interface{}, _type string, delay time.Duration) func() bool
+
+	/*
+	  Record the type of the body INotifications with a name must carry,
+	  checked by SendNotification in debug mode.
+
+	  - parameter notificationName: the name of the INotification
+	  - parameter sample: a value of the expected body type
+	*/
+	RegisterNotificationBodyType(notificationName string, sample interface{})
+
+	/*
+	  Serialize the dispatch of sent INotifications on a single goroutine.
+	*/
+	EnableNotificationQueue()
+
+	/*
+	  Dispatch any queued INotifications, then stop queueing.
+	*/
+	DisableNotificationQueue()
+
+	/*
+	  Block until every queued INotification has been dispatched.
+	*/
+	DrainNotifications()
+
 	/*
 	  Notify Observers.
 
@@ -145,4 +324,45 @@ type IFacade interface {
 	  - parameter notification: the INotification to have the View notify Observers of.
 	*/
 	NotifyObservers(notification INotification)
+
+	/*
+	  Send several INotifications, in order.
+
+	  - parameter notifications: the INotifications to send
+	*/
+	SendNotifications(notifications []INotification)
+
+	/*
+	  Create and send an INotification, reporting whether anything handles it.
+
+	  - parameter notificationName: the name of the notification to send
+	  - parameter body: the body of the notification (optional)
+	  - parameter _type: the type of the notification
+	  - returns: whether an ICommand or other IObserver was registered for the notification
+	*/
+	SendNotificationChecked(notificationName string, body interface{}, _type string) (handled bool)
+
+	/*
+	  Make INotifications of the given name sticky, so that the last one sent is
+	  replayed to any IObserver or IMediator registering for the name afterwards.
+
+	  - parameter notificationName: the name of the INotifications to keep
+	*/
+	EnableStickyNotification(notificationName string)
+
+	/*
+	  Stop keeping INotifications of the given name, releasing the last one kept.
+
+	  - parameter notificationName: the name of the INotifications to stop keeping
+	*/
+	DisableStickyNotification(notificationName string)
+
+	/*
+	  Create an INotification and collect the results of the IQueryObservers interested in it.
+
+	  - parameter notificationName: the name of the query
+	  - parameter body: the body of the query (optional)
+	  - returns: the results of the IQueryObservers, in notification order
+	*/
+	Query(notificationName string, body interface{}) []interface{}
 }
@@ -0,0 +1,27 @@
+//
+//  IFacadeNotifier.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package interfaces
+
+/*
+IFacadeNotifier The interface definition for an INotifier that can
+be initialized with a particular IFacade.
+
+The Core actors of a Facade created with facade.NewFacadeWith
+initialize the INotifiers registered with them this way, so
+that these send their INotifications to that Facade rather
+than to the Singleton.
+*/
+type IFacadeNotifier interface {
+	/*
+	  Initialize this INotifier instance with the IFacade to send INotifications to.
+
+	  - parameter facade: the IFacade of the Core actor the INotifier is registered with
+	*/
+	InitializeNotifierWith(facade IFacade)
+}
@@ -0,0 +1,40 @@
+//
+//  IFacadeTx.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package interfaces
+
+/*
+IFacadeTx The interface definition for the registrations
+of an IFacade transaction.
+
+Everything registered through it is recorded, so that the
+transaction can remove it again if it fails.
+*/
+type IFacadeTx interface {
+	/*
+	  Register an ICommand with the Controller, as part of the transaction.
+
+	  - parameter notificationName: the name of the INotification to associate the ICommand with.
+	  - parameter factory: reference that returns ICommand
+	*/
+	RegisterCommand(notificationName string, factory func() ICommand)
+
+	/*
+	  Register an IProxy with the Model, as part of the transaction.
+
+	  - parameter proxy: the IProxy to be registered with the Model.
+	*/
+	RegisterProxy(proxy IProxy)
+
+	/*
+	  Register an IMediator with the View, as part of the transaction.
+
+	  - parameter mediator: a reference to the IMediator
+	*/
+	RegisterMediator(mediator IMediator)
+}
@@ -34,6 +34,14 @@ type IModel interface {
 	*/
 	RegisterProxy(proxy IProxy)
 
+	/*
+	  Register an IProxy instance with the Model,
+	  replacing any IProxy already registered under the same name.
+
+	  - parameter proxy: an object reference to be held by the Model.
+	*/
+	RegisterProxyReplace(proxy IProxy)
+
 	/*
 	  Retrieve an IProxy instance from the Model.
 
@@ -42,6 +50,59 @@ type IModel interface {
 	*/
 	RetrieveProxy(proxyName string) IProxy
 
+	/*
+	  Turn the counting of IProxy retrievals on or off.
+
+	  - parameter enabled: whether to count retrievals
+	*/
+	EnableAccessMetrics(enabled bool)
+
+	/*
+	  Replace the data of an IProxy with a value computed from its current data, atomically.
+
+	  - parameter proxyName: the name of the IProxy
+	  - parameter fn: the func computing the new data from the old
+	*/
+	UpdateProxyData(proxyName string, fn func(old interface{}) interface{})
+
+	/*
+	  Get the number of times an IProxy was retrieved since access metrics were enabled.
+
+	  - parameter proxyName: the name of the IProxy
+	  - returns: the number of RetrieveProxy calls that found the IProxy
+	*/
+	ProxyAccessCount(proxyName string) int
+
+	/*
+	  List the names of the registered IProxy instances.
+
+	  - returns: the IProxy names, sorted
+	*/
+	ListProxyNames() []string
+
+	/*
+	  Retrieve an IProxy from the Model, registering one created by the factory if there is none.
+
+	  - parameter proxyName: the name of the IProxy
+	  - parameter factory: reference that returns the IProxy to register
+	  - returns: the IProxy registered under proxyName
+	*/
+	RetrieveProxyOrRegister(proxyName string, factory func() IProxy) IProxy
+
+	/*
+	  Count the IProxy instances registered with the Model.
+
+	  - returns: the number of registered IProxy instances
+	*/
+	ProxyCount() int
+
+	/*
+	  Retrieve every IProxy instance registered with the Model.
+
+	  - returns: a snapshot of the registered IProxy instances, ordered by name
+	*/
+	RetrieveAllProxies() []IProxy
+
 	/*
 	  Remove an IProxy instance from the Model.
 
@@ -57,4 +118,34 @@ type IModel interface {
 	  - returns: whether a Proxy is currently registered with the given proxyName.
 	*/
 	HasProxy(proxyName string) bool
+
+	/*
+	  Serialize the data of every registered IProxy.
+
+	  - returns: the JSON encoding of the registered IProxy instances
+	*/
+	Export() ([]byte, error)
+
+	/*
+	  Restore the data of the registered IProxy instances from the output of Export.
+
+	  - parameter input: the JSON encoding of IProxy instances
+	  - returns: an error if the input cannot be decoded
+	*/
+	Import(input []byte) error
+
+	/*
+	  Observe changes to the data object of a named IProxy.
+
+	  - parameter proxyName: the name of the IProxy to observe
+	  - parameter observer: the func called with the old and the new data object
+	*/
+	ObserveProxy(proxyName string, observer func(old, new interface{}))
+
+	/*
+	  Set the IFacade that the INotifiers registered with this Model are initialized with.
+
+	  - parameter facade: the IFacade owning this Model
+	*/
+	SetNotifierFacade(facade IFacade)
 }
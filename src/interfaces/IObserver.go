@@ -56,6 +56,13 @@ type IObserver interface {
 	*/
 	SetNotifyContext(notifyContext interface{})
 
+	/*
+	  Get the notification context.
+
+	  - returns: the notification context (self) of the interested object.
+	*/
+	NotifyContext() interface{}
+
 	/*
 	  Notify the interested object.
 
@@ -70,4 +77,15 @@ type IObserver interface {
 	  - returns: boolean indicating if the notification context and the object are the same.
 	*/
 	CompareNotifyContext(object interface{}) bool
+
+	/*
+	  Compare the given IObserver to this IObserver.
+
+	  Used by the View to find the IObserver to remove with RemoveObserverInstance,
+	  so distinct registrations must not be equal.
+
+	  - parameter other: the IObserver to compare.
+	  - returns: boolean indicating if both are the same IObserver.
+	*/
+	Equals(other IObserver) bool
 }
@@ -0,0 +1,27 @@
+//
+//  IQueryObserver.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package interfaces
+
+/*
+IQueryObserver The interface definition for the notification
+context of an IObserver that answers queries.
+
+When the Facade queries the IObservers of an INotification,
+those whose notification context implements it, such as an
+IMediator, contribute a result.
+*/
+type IQueryObserver interface {
+	/*
+	  Answer a query.
+
+	  - parameter notification: the INotification carrying the query
+	  - returns: the result contributed to the query
+	*/
+	Respond(notification INotification) interface{}
+}
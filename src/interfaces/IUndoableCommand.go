@@ -0,0 +1,24 @@
+//
+//  IUndoableCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package interfaces
+
+/*
+IUndoableCommand The interface definition for a PureMVC Command
+whose effect can be reverted.
+*/
+type IUndoableCommand interface {
+	ICommand
+
+	/*
+	  Revert the effect of a previous Execute with the same INotification.
+
+	  - parameter note: the INotification the ICommand was executed with.
+	*/
+	Undo(notification INotification)
+}
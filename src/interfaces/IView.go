@@ -8,6 +8,11 @@
 
 package interfaces
 
+import (
+	"context"
+	"time"
+)
+
 /*
 IView The interface definition for a PureMVC View.
 
@@ -42,6 +47,75 @@ type IView interface {
 	*/
 	RegisterObserver(notificationName string, observer IObserver)
 
+	/*
+	  Register an IObserver, returning a handle that removes it.
+
+	  - parameter notificationName: the name of the INotifications to notify this IObserver of
+	  - parameter observer: the IObserver to register
+	  - returns: a func that removes the IObserver
+	*/
+	RegisterObserverH(notificationName string, observer IObserver) func()
+
+	/*
+	  Register an IObserver under a key, replacing the IObserver registered with the same name and key.
+
+	  - parameter notificationName: the name of the INotifications to notify this IObserver of
+	  - parameter key: the key identifying the registration
+	  - parameter observer: the IObserver to register
+	*/
+	RegisterObserverKeyed(notificationName string, key string, observer IObserver)
+
+	/*
+	  Remove the IObserver registered under a key for a given Notification name.
+
+	  - parameter notificationName: which observer list to remove from
+	  - parameter key: the key given to RegisterObserverKeyed
+	  - returns: whether an IObserver was registered under the key and removed
+	*/
+	RemoveObserverKeyed(notificationName string, key string) bool
+
+	/*
+	  Register an IObserver to be notified of INotifications with a given name until a context is done.
+
+	  - parameter ctx: the context bounding the registration
+	  - parameter notificationName: the name of the INotifications to notify this IObserver of
+	  - parameter observer: the IObserver to register
+	*/
+	RegisterObserverCtx(ctx context.Context, notificationName string, observer IObserver)
+
+	/*
+	  Register an IObserver to be notified of INotifications with a given name,
+	  counting the subscriptions to it.
+
+	  - parameter notificationName: the name of the INotifications to notify this IObserver of
+	  - parameter observer: the IObserver to register
+	*/
+	RetainObserver(notificationName string, observer IObserver)
+
+	/*
+	  Release a subscription taken with RetainObserver, removing the IObserver once none is left.
+
+	  - parameter notificationName: the name of the INotifications the IObserver is registered for
+	  - parameter notifyContext: the notifyContext of the retained IObserver
+	*/
+	ReleaseObserver(notificationName string, notifyContext interface{})
+
+	/*
+	  Register an IObserver to be notified of INotifications with a given name
+	  until its IDisposed notify context is disposed.
+
+	  - parameter notificationName: the name of the INotifications to notify this IObserver of
+	  - parameter observer: the IObserver to register
+	*/
+	RegisterObserverWeak(notificationName string, observer IObserver)
+
+	/*
+	  Remove the IObservers registered with RegisterObserverWeak whose notify context is disposed.
+
+	  - returns: the number of IObservers removed
+	*/
+	SweepObservers() int
+
 	/*
 	  Remove a group of observers from the observer list for a given Notification name.
 
@@ -50,6 +124,102 @@ type IView interface {
 	*/
 	RemoveObserver(notificationName string, notifyContext interface{})
 
+	/*
+	  Change the priority of the observer for a given notifyContext
+	  in the observer list for a given Notification name.
+
+	  - parameter notificationName: which observer list to reorder
+	  - parameter notifyContext: reprioritize the observer with this object as its notifyContext
+	  - parameter priority: the new priority of the observer, higher is notified first
+	*/
+	SetObserverPriority(notificationName string, notifyContext interface{}, priority int)
+
+	/*
+	  Remove the observer for a given notifyContext from the observer list
+	  for a given Notification name, reporting whether there was one.
+
+	  - parameter notificationName: which observer list to remove from
+	  - parameter notifyContext: remove the observer with this object as its notifyContext
+	  - returns: whether an observer was removed
+	*/
+	RemoveObserverB(notificationName string, notifyContext interface{}) bool
+
+	/*
+	  Register an IObserver to be notified
+	  of INotifications with a given name and type.
+
+	  - parameter notificationName: the name of the INotifications to notify this IObserver of
+	  - parameter notificationType: the type of the INotifications to notify this IObserver of
+	  - parameter observer: the IObserver to register
+	*/
+	RegisterObserverForType(notificationName string, notificationType string, observer IObserver)
+
+	/*
+	  Register an IObserver to be notified
+	  of INotifications with a given name that satisfy a predicate.
+
+	  - parameter notificationName: the name of the INotifications to notify this IObserver of
+	  - parameter observer: the IObserver to register
+	  - parameter predicate: the func deciding whether to notify the IObserver of an INotification
+	*/
+	RegisterObserverFiltered(notificationName string, observer IObserver, predicate func(notification INotification) bool)
+
+	/*
+	  Remove a given observer instance from the observer list for a given Notification name.
+
+	  The registered IObserver that Equals the given one is removed.
+
+	  - parameter notificationName: which observer list to remove from
+	  - parameter observer: the IObserver instance to remove
+	*/
+	RemoveObserverInstance(notificationName string, observer IObserver)
+
+	/*
+	  Register an IObserver to be notified
+	  of INotifications whose name begins with a given prefix.
+
+	  - parameter prefix: the prefix of the INotification names to notify this IObserver of
+	  - parameter observer: the IObserver to register
+	*/
+	RegisterPrefixObserver(prefix string, observer IObserver)
+
+	/*
+	  Remove the observer for a given notifyContext from the observer list for a given prefix.
+
+	  - parameter prefix: which prefix observer list to remove from
+	  - parameter notifyContext: remove the observer with this object as its notifyContext
+	*/
+	RemovePrefixObserver(prefix string, notifyContext interface{})
+
+	/*
+	  Register an IObserver to be notified of every INotification,
+	  after the IObservers for its name and prefixes.
+
+	  - parameter observer: the IObserver to register
+	*/
+	RegisterGlobalObserver(observer IObserver)
+
+	/*
+	  Remove the global observer for a given notifyContext.
+
+	  - parameter notifyContext: remove the observer with this object as its notifyContext
+	*/
+	RemoveGlobalObserver(notifyContext interface{})
+
+	/*
+	  List the prefixes that have prefix observers, with "" for the global observers.
+
+	  - returns: the prefixes, sorted
+	*/
+	ListObserverPrefixes() []string
+
+	/*
+	  Remove every observer registered for a given prefix.
+
+	  - parameter prefix: which prefix observer list to remove, or "" for the global observers
+	*/
+	RemovePrefixObservers(prefix string)
+
 	/*
 	  Notify the IObservers for a particular INotification.
 
@@ -61,6 +231,70 @@ type IView interface {
 	*/
 	NotifyObservers(notification INotification)
 
+	/*
+	  Notify the IObservers for several INotifications, in order.
+
+	  - parameter notifications: the INotifications to notify IObservers of.
+	*/
+	NotifyObserversBatch(notifications []INotification)
+
+	/*
+	  Notify the IObservers for a particular INotification without copying their list first.
+
+	  Only safe if no IObserver for the INotification is registered or removed until it completes.
+
+	  - parameter notification: the INotification to notify IObservers of.
+	*/
+	NotifyObserversNoCopy(notification INotification)
+
+	/*
+	  Turn the measurement of NotifyObservers on or off.
+
+	  - parameter enabled: whether to measure dispatches
+	*/
+	EnableDispatchMetrics(enabled bool)
+
+	/*
+	  Get the dispatch metrics gathered since they were enabled.
+
+	  - returns: a copy of the mapping of INotification names to the number of NotifyObservers calls and the total time they spent notifying IObservers
+	*/
+	DispatchMetrics() map[string]struct {
+		Count int
+		Total time.Duration
+	}
+
+	/*
+	  Make INotifications of the given name sticky, so that the last one sent is
+	  replayed to any IObserver or IMediator registering for the name afterwards.
+
+	  - parameter notificationName: the name of the INotifications to keep
+	*/
+	EnableStickyNotification(notificationName string)
+
+	/*
+	  Stop keeping INotifications of the given name, releasing the last one kept.
+
+	  - parameter notificationName: the name of the INotifications to stop keeping
+	*/
+	DisableStickyNotification(notificationName string)
+
+	/*
+	  Notify the IObservers for a particular INotification,
+	  giving each IObserver its own copy of an ICloneable body.
+
+	  - parameter notification: the INotification to notify IObservers of.
+	*/
+	NotifyObserversIsolated(notification INotification)
+
+	/*
+	  Query the IObservers for a particular INotification whose notification context is an IQueryObserver.
+
+	  - parameter notification: the INotification carrying the query
+	  - returns: the results of the IQueryObservers, in notification order
+	*/
+	QueryObservers(notification INotification) []interface{}
+
 	/*
 	  Register an IMediator instance with the View.
 
@@ -79,6 +313,14 @@ type IView interface {
 	*/
 	RegisterMediator(mediator IMediator)
 
+	/*
+	  Register an IMediator instance with the View,
+	  replacing any IMediator already registered under the same name.
+
+	  - parameter mediator: a reference to the IMediator instance
+	*/
+	RegisterMediatorReplace(mediator IMediator)
+
 	/*
 	  Retrieve an IMediator from the View.
 
@@ -87,6 +329,98 @@ type IView interface {
 	*/
 	RetrieveMediator(mediatorName string) IMediator
 
+	/*
+	  List the names of the registered IMediator instances.
+
+	  - returns: the IMediator names, sorted
+	*/
+	ListMediatorNames() []string
+
+	/*
+	  Retrieve an IMediator from the View, registering one created by the factory if there is none.
+
+	  - parameter mediatorName: the name of the IMediator
+	  - parameter factory: reference that returns the IMediator to register
+	  - returns: the IMediator registered under mediatorName
+	*/
+	RetrieveMediatorOrRegister(mediatorName string, factory func() IMediator) IMediator
+
+	/*
+	  Register an IMediator instance with the View as a member of a named group.
+
+	  - parameter mediator: a reference to the IMediator instance
+	  - parameter group: the name of the group
+	*/
+	RegisterMediatorInGroup(mediator IMediator, group string)
+
+	/*
+	  Register several IMediator instances with the View, all of them or none.
+
+	  - parameter mediators: the IMediator instances to register
+	  - returns: nil, or an error naming the first name collision
+	*/
+	RegisterMediatorsStrict(mediators []IMediator) error
+
+	/*
+	  Notify the IMediators of a group that are interested in a particular INotification.
+
+	  - parameter group: the name of the group
+	  - parameter notification: the INotification to notify the IMediators of.
+	*/
+	NotifyGroup(group string, notification INotification)
+
+	/*
+	  List the names of the registered IMediator instances in the order they were registered.
+
+	  - returns: the names of the registered IMediator instances, earliest registration first
+	*/
+	MediatorRegistrationOrder() []string
+
+	/*
+	  Count the IMediator instances registered with the View.
+
+	  - returns: the number of registered IMediator instances
+	*/
+	MediatorCount() int
+
+	/*
+	  Retrieve every IMediator instance registered with the View.
+
+	  - returns: a snapshot of the registered IMediator instances, ordered by name
+	*/
+	RetrieveAllMediators() []IMediator
+
+	/*
+	  Count the IObservers registered for each INotification name.
+
+	  - returns: a mapping of INotification names to the length of their observer lists
+	*/
+	ObserverCounts() map[string]int
+
+	/*
+	  Check if any IObserver would be notified of INotifications with a particular name.
+
+	  - parameter notificationName: the name of the INotification
+	  - returns: whether an IObserver is registered for the name or a prefix of it
+	*/
+	HasObservers(notificationName string) bool
+
+	/*
+	  List the notification contexts of the IObservers registered for a particular INotification name.
+
+	  - parameter notificationName: the name of the INotification
+	  - returns: the notification contexts, in notification order
+	*/
+	ObserverContexts(notificationName string) []interface{}
+
+	/*
+	  List the INotification names an IMediator is actually registered to observe.
+
+	  - parameter mediatorName: the name of the IMediator
+	  - returns: the INotification names, sorted
+	*/
+	MediatorInterests(mediatorName string) []string
+
 	/*
 	  Remove an IMediator from the View.
 
@@ -102,4 +436,11 @@ type IView interface {
 	  - returns: whether a Mediator is registered with the given mediatorName.
 	*/
 	HasMediator(mediatorName string) bool
+
+	/*
+	  Set the IFacade that the INotifiers registered with this View are initialized with.
+
+	  - parameter facade: the IFacade owning this View
+	*/
+	SetNotifierFacade(facade IFacade)
 }
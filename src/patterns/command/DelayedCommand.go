@@ -0,0 +1,46 @@
+//
+//  DelayedCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import "time"
+
+/*
+DelayedCommand A base ICommand implementation that can
+schedule INotifications to be sent later.
+
+For polling-style flows, a subclass can re-trigger its own
+INotification from its Execute method:
+
+	func (self *PollCommand) Execute(notification interfaces.INotification) {
+	  // poll...
+	  self.ScheduleNotification(notification.Name(), notification.Body(), notification.Type(), time.Second)
+	}
+
+Pending INotifications are cancelled when the Facade is shut down.
+*/
+type DelayedCommand struct {
+	SimpleCommand
+}
+
+/*
+ScheduleNotification Create an INotification and send it after a delay.
+
+- parameter notificationName: the name of the notification to send
+
+- parameter body: the body of the notification (optional)
+
+- parameter _type: the type of the notification
+
+- parameter delay: how long to wait before sending
+
+- returns: a func cancelling the INotification, reporting whether it was still pending
+*/
+func (self *DelayedCommand) ScheduleNotification(notificationName string, body interface{}, _type string, delay time.Duration) func() bool {
+	return self.Facade.SendNotificationAfter(notificationName, body, _type, delay)
+}
@@ -35,6 +35,21 @@ to be executed.
 type MacroCommand struct {
 	facade.Notifier
 	SubCommands []func() interfaces.ICommand
+
+	// Initializer, if set, is called instead of InitializeMacroCommand
+	// to add the SubCommands. Go has no virtual methods, so the base
+	// MacroCommand cannot call a subclass's InitializeMacroCommand;
+	// set Initializer to it when creating the subclass instead of
+	// overriding Execute and PrepareSubCommands.
+	Initializer func()
+
+	// InitializerWith, if set, is called by Execute instead of the
+	// Initializer with the INotification being handled, so that the
+	// SubCommands can depend on it. Set it to the subclass's own
+	// InitializeMacroCommandWith when creating the subclass.
+	InitializerWith func(notification interfaces.INotification)
+
+	prepared bool // whether PrepareSubCommands added the SubCommands
 }
 
 /*
@@ -59,6 +74,41 @@ func (self *MacroCommand) InitializeMacroCommand() {
 
 }
 
+/*
+InitializeMacroCommandWith Initialize the MacroCommand for a particular INotification.
+
+Called by Execute with the INotification being handled, so that
+the SubCommands can depend on it, such as on its type or body.
+This calls the InitializerWith, if set, or else the Initializer,
+if set, or else InitializeMacroCommand.
+
+Go has no virtual methods, so a subclass does not override this
+method but sets the InitializerWith to its own initializer, and
+inherits Execute:
+
+	func NewMyMacroCommand() *MyMacroCommand {
+	  command := &MyMacroCommand{}
+	  command.InitializerWith = command.addSubCommandsFor
+	  return command
+	}
+
+	func (self *MyMacroCommand) addSubCommandsFor(notification interfaces.INotification) {
+	  if notification.Type() == "import" {
+	    self.AddSubCommand(func() interfaces.ICommand { return &ImportCommand{} })
+	  }
+	  self.AddSubCommand(func() interfaces.ICommand { return &RefreshCommand{} })
+	}
+
+- parameter notification: the INotification the MacroCommand executes for
+*/
+func (self *MacroCommand) InitializeMacroCommandWith(notification interfaces.INotification) {
+	if self.InitializerWith != nil {
+		self.InitializerWith(notification)
+		return
+	}
+	self.initialize()
+}
+
 /*
 AddSubCommand Add a SubCommand.
 
@@ -71,22 +121,77 @@ func (self *MacroCommand) AddSubCommand(factory func() interfaces.ICommand) {
 	self.SubCommands = append(self.SubCommands, factory)
 }
 
+/*
+PrepareSubCommands Initialize the MacroCommand's SubCommands
+without executing them.
+
+This leaves the SubCommands list populated so that its
+composition can be inspected, for instance with SubCommandCount.
+The SubCommands are added by the Initializer, if set, and are
+added once: neither a second call nor Execute adds them again.
+
+A subclass sets the Initializer to its own InitializeMacroCommand:
+
+	func NewMyMacroCommand() *MyMacroCommand {
+	  command := &MyMacroCommand{}
+	  command.Initializer = command.InitializeMacroCommand
+	  return command
+	}
+*/
+func (self *MacroCommand) PrepareSubCommands() {
+	if self.prepared {
+		return
+	}
+	self.initialize()
+	self.prepared = true
+}
+
+/*
+initialize Add the SubCommands with the Initializer, if set,
+or else with InitializeMacroCommand.
+*/
+func (self *MacroCommand) initialize() {
+	if self.Initializer != nil {
+		self.Initializer()
+		return
+	}
+	self.InitializeMacroCommand()
+}
+
+/*
+SubCommandCount Get the number of SubCommands waiting to be executed.
+
+- returns: the length of the SubCommands list
+*/
+func (self *MacroCommand) SubCommandCount() int {
+	return len(self.SubCommands)
+}
+
 /*
 Execute this MacroCommand's SubCommands.
 
-The SubCommands will be called in First In/First Out (FIFO)
-order.
+The SubCommands are first initialized by InitializeMacroCommandWith,
+unless PrepareSubCommands already added them, then called in
+First In/First Out (FIFO) order.
 
 - parameter notification: the INotification object to be passsed to each SubCommand.
 */
 func (self *MacroCommand) Execute(notification interfaces.INotification) {
-	self.InitializeMacroCommand()
+	if !self.prepared {
+		self.InitializeMacroCommandWith(notification)
+	}
+	self.prepared = false
 	for len(self.SubCommands) > 0 {
 		factory := self.SubCommands[0]
 		self.SubCommands = self.SubCommands[1:]
 
 		commandInstance := factory()
-		commandInstance.InitializeNotifier()
+		if bound, ok := commandInstance.(interfaces.IFacadeNotifier); ok && self.Facade != nil {
+			// SubCommands send to the Facade this MacroCommand was initialized with
+			bound.InitializeNotifierWith(self.Facade)
+		} else {
+			commandInstance.InitializeNotifier()
+		}
 		commandInstance.Execute(notification)
 	}
 }
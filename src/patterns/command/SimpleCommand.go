@@ -9,6 +9,7 @@
 package command
 
 import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
 )
@@ -18,9 +19,21 @@ SimpleCommand A base ICommand implementation.
 
 Your subclass should override the execute
 method where your business logic will handle the INotification.
+
+SimpleCommand is a complete ICommand on its own: its Execute
+does nothing, so a subclass that forgets to override it runs
+harmlessly rather than failing to satisfy ICommand.
+
+A command signalling that it is done can set CompletionName
+in its factory and call Complete with its result:
+
+	f.RegisterCommand(LOGIN, func() interfaces.ICommand {
+	  return &LoginCommand{command.SimpleCommand{CompletionName: LOGIN_DONE}}
+	})
 */
 type SimpleCommand struct {
 	facade.Notifier
+	CompletionName string // The name of the INotification sent by Complete
 }
 
 /*
@@ -31,8 +44,27 @@ begins with some user action, which results in an INotification being broadcast,
 is handled by business logic in the execute method of an
 ICommand.
 
+The default implementation does nothing; subclasses override it.
+
 - parameter notification: the INotification to handle.
 */
 func (self *SimpleCommand) Execute(notification interfaces.INotification) {
 
 }
+
+/*
+Complete Send the completion INotification with the given result.
+
+The INotification is named CompletionName. Calling Complete
+without a CompletionName is reported through debug.Report
+and sends nothing.
+
+- parameter resultBody: the body of the completion notification
+*/
+func (self *SimpleCommand) Complete(resultBody interface{}) {
+	if self.CompletionName == "" {
+		debug.Report("Complete called on a SimpleCommand without a CompletionName")
+		return
+	}
+	self.SendNotification(self.CompletionName, resultBody, "")
+}
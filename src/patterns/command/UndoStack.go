@@ -0,0 +1,127 @@
+//
+//  UndoStack.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"sync"
+)
+
+/*
+UndoStack A record of executed IUndoableCommands.
+
+Register the IUndoableCommands with the factory returned
+by Record, so that each one is pushed onto the stack once
+it has executed:
+
+	facade.RegisterCommand(MOVE, undoStack.Record(func() interfaces.IUndoableCommand { return &MoveCommand{} }))
+
+Undo then reverts the executed IUndoableCommands in
+reverse order.
+*/
+type UndoStack struct {
+	entries []undoEntry // the executed IUndoableCommands, most recent last
+	mutex   sync.Mutex  // Mutex for entries
+}
+
+/*
+undoEntry An executed IUndoableCommand and the INotification it handled.
+*/
+type undoEntry struct {
+	command      interfaces.IUndoableCommand
+	notification interfaces.INotification
+}
+
+/*
+undoRecordingCommand An ICommand that executes an IUndoableCommand
+and pushes it onto an UndoStack.
+*/
+type undoRecordingCommand struct {
+	interfaces.IUndoableCommand
+	stack *UndoStack
+}
+
+/*
+Execute Execute the IUndoableCommand, then push it onto the UndoStack.
+
+- parameter notification: the INotification to handle.
+*/
+func (self *undoRecordingCommand) Execute(notification interfaces.INotification) {
+	self.IUndoableCommand.Execute(notification)
+	self.stack.Push(self.IUndoableCommand, notification)
+}
+
+/*
+Record Wrap a factory of IUndoableCommands for registration with the Controller.
+
+- parameter factory: reference that returns IUndoableCommand
+
+- returns: reference that returns an ICommand pushing the IUndoableCommand onto this UndoStack once executed
+*/
+func (self *UndoStack) Record(factory func() interfaces.IUndoableCommand) func() interfaces.ICommand {
+	return func() interfaces.ICommand {
+		return &undoRecordingCommand{IUndoableCommand: factory(), stack: self}
+	}
+}
+
+/*
+Push Record an executed IUndoableCommand.
+
+- parameter command: the IUndoableCommand that was executed
+
+- parameter notification: the INotification it was executed with
+*/
+func (self *UndoStack) Push(command interfaces.IUndoableCommand, notification interfaces.INotification) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.entries = append(self.entries, undoEntry{command: command, notification: notification})
+}
+
+/*
+Undo Revert the most recently executed IUndoableCommand and remove it from the stack.
+
+The IUndoableCommand is reverted outside of the lock, so that
+its Undo may itself execute recorded commands.
+
+- returns: false if the stack was empty
+*/
+func (self *UndoStack) Undo() bool {
+	self.mutex.Lock()
+	if len(self.entries) == 0 {
+		self.mutex.Unlock()
+		return false
+	}
+	entry := self.entries[len(self.entries)-1]
+	self.entries = self.entries[:len(self.entries)-1]
+	self.mutex.Unlock()
+
+	entry.command.Undo(entry.notification)
+	return true
+}
+
+/*
+UndoAll Revert every recorded IUndoableCommand, most recent first.
+*/
+func (self *UndoStack) UndoAll() {
+	for self.Undo() {
+	}
+}
+
+/*
+Len The number of recorded IUndoableCommands.
+
+- returns: the number of IUndoableCommands that can be undone
+*/
+func (self *UndoStack) Len() int {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return len(self.entries)
+}
@@ -0,0 +1,94 @@
+//
+//  Topic.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package event
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+)
+
+/*
+Topic A typed INotification name.
+
+A Topic pairs an INotification name with the type of the
+body its INotifications carry, so that publishers and
+subscribers agree on the body type at compile time:
+
+	var LOGIN = event.NewTopic[*LoginVO]("login")
+
+	LOGIN.Subscribe(view, func(vo *LoginVO) { ... })
+	LOGIN.Publish(facade, &LoginVO{User: "admin"})
+
+Topics are sent as ordinary INotifications, so ICommands,
+IMediators and IObservers registered for the name still
+receive them.
+*/
+type Topic[T any] struct {
+	Name string // the name of the INotifications
+}
+
+/*
+NewTopic Create a Topic.
+
+- parameter name: the name of the INotifications
+
+- returns: the Topic
+*/
+func NewTopic[T any](name string) *Topic[T] {
+	return &Topic[T]{Name: name}
+}
+
+/*
+Publish Send an INotification carrying the payload as its body.
+
+- parameter facade: the IFacade used to send the INotification
+
+- parameter payload: the body of the INotification
+*/
+func (self *Topic[T]) Publish(facade interfaces.IFacade, payload T) {
+	facade.SendNotification(self.Name, payload, "")
+}
+
+/*
+Subscribe Register a handler for the payloads of the INotifications of this Topic.
+
+INotifications sent under the name of the Topic whose body
+is not a T, such as ones sent without Publish, are ignored.
+
+The returned IObserver is its own notify context, so it can be
+removed with Unsubscribe or view.RemoveObserver(topic.Name, observer).
+
+- parameter view: the IView to register the IObserver with
+
+- parameter handler: the func called with the payload
+
+- returns: the registered IObserver
+*/
+func (self *Topic[T]) Subscribe(view interfaces.IView, handler func(payload T)) interfaces.IObserver {
+	subscriber := &observer.Observer{}
+	subscriber.Notify = func(notification interfaces.INotification) {
+		if payload, ok := notification.Body().(T); ok {
+			handler(payload)
+		}
+	}
+	subscriber.Context = subscriber
+	view.RegisterObserver(self.Name, subscriber)
+	return subscriber
+}
+
+/*
+Unsubscribe Remove an IObserver returned by Subscribe.
+
+- parameter view: the IView the IObserver is registered with
+
+- parameter subscriber: the IObserver returned by Subscribe
+*/
+func (self *Topic[T]) Unsubscribe(view interfaces.IView, subscriber interfaces.IObserver) {
+	view.RemoveObserver(self.Name, subscriber)
+}
@@ -9,14 +9,23 @@
 package facade
 
 import (
+	"fmt"
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/controller"
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/model"
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
+const AWAIT_RESPONSE_SUFFIX = "/done" // suffix of the name of the INotifications answering SendNotificationAwait
+
 /*
 Facade represents a base implementation of the Singleton pattern for IFacade.
 A base Singleton IFacade implementation.
@@ -25,10 +34,44 @@ type Facade struct {
 	controller interfaces.IController // Reference to the Controller
 	model      interfaces.IModel      // Reference to the Model
 	view       interfaces.IView       // Reference to the View
+	isolated   bool                   // Whether the Facade was created by NewFacadeWith rather than GetInstance
+
+	queue        []interfaces.INotification // Notifications waiting to be dispatched by the queue goroutine
+	queuePending int                        // Notifications enqueued but not yet fully dispatched
+	queueEnabled bool                       // Whether SendNotification enqueues rather than dispatches
+	queueRunning bool                       // Whether the queue goroutine is running
+	queueMutex   sync.Mutex                 // Mutex for the notification queue
+	queueCond    *sync.Cond                 // Signals changes to the notification queue
+
+	middleware      []func(notification interfaces.INotification, next func()) // Middleware chain wrapping NotifyObservers
+	middlewareMutex sync.RWMutex                                               // Mutex for middleware
+
+	deferred      []interfaces.INotification // Notifications waiting for the dispatch in progress to return
+	dispatchDepth int                        // NotifyObservers calls in progress
+	deferredMutex sync.Mutex                 // Mutex for deferred and dispatchDepth
+
+	bodyTypes      map[string]reflect.Type // Mapping of Notification names to their expected body type
+	bodyTypesMutex sync.RWMutex            // Mutex for bodyTypes
+
+	bodyTransformers      map[string][]func(body interface{}) interface{} // Mapping of Notification names to their body transformers, in registration order
+	bodyTransformersMutex sync.RWMutex                                    // Mutex for bodyTransformers
+
+	schedules      map[uint64]*time.Timer // Mapping of ids to the timers of the Notifications scheduled with SendNotificationAfter
+	scheduleId     uint64                 // Id of the last Notification scheduled
+	schedulesMutex sync.Mutex             // Mutex for schedules and scheduleId
+
+	// OnUnhandledNotification, if set, is called with each
+	// INotification that reaches the View while no IObserver,
+	// and so no ICommand or IMediator, is registered for its
+	// name, to surface misspelled names during development.
+	// The View is still notified of such INotifications.
+	// Set it before any INotifications are sent.
+	OnUnhandledNotification func(notification interfaces.INotification)
 }
 
 var instance interfaces.IFacade    // The Singleton Facade instance.
 var instanceMutex = sync.RWMutex{} // instanceMutex for the instance
+var awaitSequence atomic.Uint64    // source of the correlation ids of SendNotificationAwait
 
 /*
 GetInstance is a Facade Singleton factory method.
@@ -45,11 +88,71 @@ func GetInstance(factory func() interfaces.IFacade) interfaces.IFacade {
 
 	if instance == nil {
 		instance = factory()
+		instance.PreInitialize()
 		instance.InitializeFacade()
+		instance.PostInitialize()
 	}
 	return instance
 }
 
+/*
+NewFacadeWith Create a Facade, not the Singleton instance, bound to the given Core actors.
+
+Together with view.NewView, model.NewModel and controller.NewController,
+this builds PureMVC instances that share no registrations with the
+Singletons or with each other:
+
+	v := view.NewView()
+	f := facade.NewFacadeWith(model.NewModel(), v, controller.NewController(v))
+
+The IController should register its Observers with the given IView.
+Shutdown clears the Facade's actors without touching the Singletons.
+
+The actors are given the Facade with SetNotifierFacade, so that
+they initialize the ICommands, IMediators and IProxies registered
+with them with InitializeNotifierWith: INotifications sent through
+their Notifiers reach this Facade, not the Singleton. An INotifier
+that only implements InitializeNotifier still binds to the Singleton.
+
+- parameter model: the IModel of the Facade
+
+- parameter view: the IView of the Facade
+
+- parameter controller: the IController of the Facade
+
+- returns: the Facade bound to the given actors
+*/
+func NewFacadeWith(model interfaces.IModel, view interfaces.IView, controller interfaces.IController) interfaces.IFacade {
+	facade := &Facade{model: model, view: view, controller: controller, isolated: true}
+	model.SetNotifierFacade(facade)
+	view.SetNotifierFacade(facade)
+	controller.SetNotifierFacade(facade)
+	return facade
+}
+
+/*
+HasInstance Check if the Singleton Facade instance exists, without creating it.
+
+- returns: whether GetInstance has created the instance
+*/
+func HasInstance() bool {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+
+	return instance != nil
+}
+
+/*
+Default Get the Singleton Facade instance, creating a Facade if there is none.
+
+Shorthand for GetInstance(func() interfaces.IFacade { return &Facade{} }).
+
+- returns: the Singleton instance
+*/
+func Default() interfaces.IFacade {
+	return GetInstance(func() interfaces.IFacade { return &Facade{} })
+}
+
 /*
 InitializeFacade Initialize the Singleton Facade instance.
 
@@ -63,6 +166,30 @@ func (self *Facade) InitializeFacade() {
 	self.InitializeView()
 }
 
+/*
+PreInitialize Prepare the Singleton Facade instance.
+
+Called automatically by the GetInstance, before InitializeFacade,
+so none of the Model, Controller or View may exist yet. Does
+nothing by default; override in your subclass for setup that
+must come first, rather than overriding InitializeFacade.
+*/
+func (self *Facade) PreInitialize() {
+
+}
+
+/*
+PostInitialize Complete the Singleton Facade instance.
+
+Called automatically by the GetInstance, after InitializeFacade.
+The Model, Controller and View all exist by then, so setup
+that depends on several of them, in whatever order, belongs
+here. Does nothing by default; override in your subclass.
+*/
+func (self *Facade) PostInitialize() {
+
+}
+
 /*
 InitializeController Initialize the Controller.
 
@@ -132,6 +259,17 @@ func (self *Facade) InitializeView() {
 	self.view = view.GetInstance(func() interfaces.IView { return &view.View{} })
 }
 
+/*
+ReadOnly Get a restricted view of this Facade, for plugins
+that may send INotifications and look up registrations but
+must not register or remove anything.
+
+- returns: a ReadOnlyFacade delegating to this Facade
+*/
+func (self *Facade) ReadOnly() *ReadOnlyFacade {
+	return &ReadOnlyFacade{facade: self}
+}
+
 /*
 RegisterCommand Register an ICommand with the Controller by Notification name.
 
@@ -143,6 +281,22 @@ func (self *Facade) RegisterCommand(notificationName string, factory func() inte
 	self.controller.RegisterCommand(notificationName, factory)
 }
 
+/*
+RegisterCommandFunc Register a func as the handler
+for a particular INotification.
+
+The func is wrapped in an ICommand and registered as with
+RegisterCommand, so a trivial handler needs no ICommand type
+of its own.
+
+- parameter notificationName: the name of the INotification to associate the handler with.
+
+- parameter handler: the func handling the INotification
+*/
+func (self *Facade) RegisterCommandFunc(notificationName string, handler func(notification interfaces.INotification)) {
+	self.controller.RegisterCommand(notificationName, func() interfaces.ICommand { return &funcCommand{handler: handler} })
+}
+
 /*
 RemoveCommand Remove a previously registered ICommand to INotification mapping from the Controller.
 
@@ -152,6 +306,18 @@ func (self *Facade) RemoveCommand(notificationName string) {
 	self.controller.RemoveCommand(notificationName)
 }
 
+/*
+RemoveCommandB Remove a previously registered ICommand to INotification mapping from the Controller,
+reporting whether there was one.
+
+- parameter notificationName: the name of the INotification to remove the ICommand mapping for
+
+- returns: whether an ICommand mapping was registered and removed
+*/
+func (self *Facade) RemoveCommandB(notificationName string) bool {
+	return self.controller.RemoveCommandB(notificationName)
+}
+
 /*
 HasCommand Check if a Command is registered for a given Notification
 
@@ -163,6 +329,15 @@ func (self *Facade) HasCommand(notificationName string) bool {
 	return self.controller.HasCommand(notificationName)
 }
 
+/*
+CommandCount Count the INotification names that have an ICommand mapping.
+
+- returns: the number of INotification names that have an ICommand mapping
+*/
+func (self *Facade) CommandCount() int {
+	return self.controller.CommandCount()
+}
+
 /*
 RegisterProxy Register an IProxy with the Model by name.
 
@@ -172,6 +347,16 @@ func (self *Facade) RegisterProxy(proxy interfaces.IProxy) {
 	self.model.RegisterProxy(proxy)
 }
 
+/*
+RegisterProxyReplace Register an IProxy with the Model,
+replacing any IProxy already registered under the same name.
+
+- parameter proxy: the IProxy instance to be registered with the Model.
+*/
+func (self *Facade) RegisterProxyReplace(proxy interfaces.IProxy) {
+	self.model.RegisterProxyReplace(proxy)
+}
+
 /*
 RetrieveProxy Retrieve an IProxy from the Model by name.
 
@@ -183,6 +368,39 @@ func (self *Facade) RetrieveProxy(proxyName string) interfaces.IProxy {
 	return self.model.RetrieveProxy(proxyName)
 }
 
+/*
+RetrieveProxyData Retrieve the data of an IProxy from the Model by name.
+
+- parameter proxyName: the name of the proxy whose data is retrieved.
+
+- returns: the data of the IProxy, and whether an IProxy is registered with the given proxyName
+*/
+func (self *Facade) RetrieveProxyData(proxyName string) (interface{}, bool) {
+	proxy := self.model.RetrieveProxy(proxyName)
+	if proxy == nil {
+		return nil, false
+	}
+	return proxy.GetData(), true
+}
+
+/*
+RetrieveAllProxies Retrieve every IProxy registered with the Model.
+
+- returns: a snapshot of the registered IProxy instances, ordered by name
+*/
+func (self *Facade) RetrieveAllProxies() []interfaces.IProxy {
+	return self.model.RetrieveAllProxies()
+}
+
+/*
+ProxyCount Count the IProxy instances registered with the Model.
+
+- returns: the number of IProxy instances registered with the Model
+*/
+func (self *Facade) ProxyCount() int {
+	return self.model.ProxyCount()
+}
+
 /*
 RemoveProxy Remove an IProxy from the Model by name.
 
@@ -214,6 +432,16 @@ func (self *Facade) RegisterMediator(mediator interfaces.IMediator) {
 	self.view.RegisterMediator(mediator)
 }
 
+/*
+RegisterMediatorReplace Register a IMediator with the View,
+replacing any IMediator already registered under the same name.
+
+- parameter mediator: a reference to the IMediator
+*/
+func (self *Facade) RegisterMediatorReplace(mediator interfaces.IMediator) {
+	self.view.RegisterMediatorReplace(mediator)
+}
+
 /*
 RetrieveMediator Retrieve an IMediator from the View.
 
@@ -225,6 +453,24 @@ func (self *Facade) RetrieveMediator(mediatorName string) interfaces.IMediator {
 	return self.view.RetrieveMediator(mediatorName)
 }
 
+/*
+RetrieveAllMediators Retrieve every IMediator registered with the View.
+
+- returns: a snapshot of the registered IMediator instances, ordered by name
+*/
+func (self *Facade) RetrieveAllMediators() []interfaces.IMediator {
+	return self.view.RetrieveAllMediators()
+}
+
+/*
+MediatorCount Count the IMediator instances registered with the View.
+
+- returns: the number of IMediator instances registered with the View
+*/
+func (self *Facade) MediatorCount() int {
+	return self.view.MediatorCount()
+}
+
 /*
 RemoveMediator Remove an IMediator from the View.
 
@@ -247,6 +493,109 @@ func (self *Facade) HasMediator(mediatorName string) bool {
 	return self.view.HasMediator(mediatorName)
 }
 
+/*
+Transaction Register a feature's ICommands, IProxies and IMediators,
+removing them all if the registration fails.
+
+The fn registers through the given IFacadeTx, which records each
+registration. If fn returns an error, everything it registered is
+removed again, with OnRemove called on the IProxies and IMediators,
+and the error is returned. Registrations that replaced existing
+ones are removed, not reverted to what they replaced.
+
+- parameter fn: the func registering the feature
+
+- returns: the error returned by fn
+*/
+func (self *Facade) Transaction(fn func(tx interfaces.IFacadeTx) error) error {
+	var tx = &facadeTx{facade: self}
+	if err := fn(tx); err != nil {
+		tx.rollback()
+		return err
+	}
+	return nil
+}
+
+/*
+Shutdown Remove every Command, Mediator, Proxy and prefix or global
+Observer and reset the Core actors.
+
+Any queued INotifications are dispatched first, and the
+INotifications scheduled with SendNotificationAfter are cancelled.
+The Controller's worker pool, if enabled, is drained once the
+Commands are removed.
+
+Mediators and Proxies have their OnRemove called as they are removed.
+Afterwards the Singleton Facade, Controller, Model and View are
+discarded, so the next GetInstance starts the framework afresh;
+a Facade created with NewFacadeWith leaves the Singletons alone.
+
+This Facade must not be used after Shutdown returns.
+*/
+func (self *Facade) Shutdown() {
+	self.cancelSchedules()
+	self.DisableNotificationQueue()
+
+	for _, notificationName := range self.controller.ListCommandNames() {
+		self.controller.RemoveCommand(notificationName)
+	}
+	for _, prefix := range self.controller.ListCommandPrefixes() {
+		self.controller.RemoveCommandPrefix(prefix)
+	}
+	self.controller.Shutdown()
+	for _, mediatorName := range self.view.ListMediatorNames() {
+		self.view.RemoveMediator(mediatorName)
+	}
+	for _, prefix := range self.view.ListObserverPrefixes() {
+		self.view.RemovePrefixObservers(prefix)
+	}
+	for _, proxyName := range self.model.ListProxyNames() {
+		self.model.RemoveProxy(proxyName)
+	}
+
+	if self.isolated {
+		return
+	}
+
+	controller.RemoveController()
+	model.RemoveModel()
+	view.RemoveView()
+
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+
+	instance = nil
+}
+
+/*
+DumpState Describe the registered Commands, Proxies, Mediators and Observers.
+
+Intended for debugging and bug reports, this only reads
+from the Core actors and never modifies them.
+
+- returns: a human readable snapshot of the application's PureMVC state
+*/
+func (self *Facade) DumpState() string {
+	counts := self.view.ObserverCounts()
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	observers := make([]string, len(names))
+	for index, name := range names {
+		observers[index] = fmt.Sprintf("%s (%d)", name, counts[name])
+	}
+
+	msg := "Commands: " + strings.Join(self.controller.ListCommandNames(), ", ")
+	msg += "\nProxies: " + strings.Join(self.model.ListProxyNames(), ", ")
+	msg += "\nMediators: " + strings.Join(self.view.ListMediatorNames(), ", ")
+	msg += "\nObservers: " + strings.Join(observers, ", ")
+
+	return msg
+}
+
 /*
 SendNotification Create and send an INotification.
 
@@ -260,7 +609,282 @@ instances in our implementation code.
 - parameter _type: the type of the notification
 */
 func (self *Facade) SendNotification(notificationName string, body interface{}, _type string) {
-	self.NotifyObservers(observer.NewNotification(notificationName, body, _type))
+	self.sendNotification(observer.NewNotification(notificationName, body, _type))
+}
+
+/*
+SendNotificationChecked Create and send an INotification,
+reporting whether anything handles it.
+
+The IObservers are checked as the INotification is sent, so an
+ICommand registered with RegisterCommandOnce counts even though
+it is removed by the notification. IObservers registered for a
+prefix of the name, including global IObservers, count too.
+If the notification queue is enabled, the INotification is
+queued as usual, and the result reflects the IObservers
+registered when it was sent rather than when it is dispatched.
+
+- parameter notificationName: the name of the notification to send
+
+- parameter body: the body of the notification (optional)
+
+- parameter _type: the type of the notification
+
+- returns: whether an ICommand or other IObserver was registered for the notification
+*/
+func (self *Facade) SendNotificationChecked(notificationName string, body interface{}, _type string) (handled bool) {
+	handled = self.view.HasObservers(notificationName)
+	self.SendNotification(notificationName, body, _type)
+	return handled
+}
+
+/*
+EnableStickyNotification Make INotifications of the given name sticky.
+
+The View keeps the last INotification sent with the name and
+replays it to any IObserver or IMediator that registers for the
+name afterwards, so late subscribers see the current state
+without waiting for the next one. Commands are not replayed.
+
+The kept INotification and its body stay in memory until the
+next one of the same name replaces it or
+DisableStickyNotification is called.
+
+- parameter notificationName: the name of the INotifications to keep
+*/
+func (self *Facade) EnableStickyNotification(notificationName string) {
+	self.view.EnableStickyNotification(notificationName)
+}
+
+/*
+DisableStickyNotification Stop keeping INotifications of the given name,
+releasing the last one kept.
+
+- parameter notificationName: the name of the INotifications to stop keeping
+*/
+func (self *Facade) DisableStickyNotification(notificationName string) {
+	self.view.DisableStickyNotification(notificationName)
+}
+
+/*
+SendNotifications Send several INotifications, in order.
+
+Each INotification is sent as SendNotification would send it:
+it goes through the queue, if enabled, and the middleware chain.
+
+- parameter notifications: the INotifications to send
+*/
+func (self *Facade) SendNotifications(notifications []interfaces.INotification) {
+	for _, notification := range notifications {
+		self.sendNotification(notification)
+	}
+}
+
+/*
+Query Create an INotification and collect the results of
+the IQueryObservers interested in it.
+
+The IObservers of the INotification whose notification
+context implements IQueryObserver, such as an IMediator,
+have their Respond called; other IObservers, including
+ICommands, are not notified. The query bypasses the
+notification queue and middleware.
+
+- parameter notificationName: the name of the query
+
+- parameter body: the body of the query (optional)
+
+- returns: the results of the IQueryObservers, in notification order
+*/
+func (self *Facade) Query(notificationName string, body interface{}) []interface{} {
+	return self.view.QueryObservers(observer.NewNotification(notificationName, body, ""))
+}
+
+/*
+sendNotification Run the body of the INotification through its
+transformers, then send it with sendTransformed.
+
+The INotification passed in is left as is: if its body is
+transformed, a new INotification with the same name and type
+carries the transformed body, so that an INotification sent
+again is not transformed twice.
+
+- parameter notification: the INotification to send
+*/
+func (self *Facade) sendNotification(notification interfaces.INotification) {
+	if body, transformed := self.transformBody(notification.Name(), notification.Body()); transformed {
+		notification = observer.NewNotification(notification.Name(), body, notification.Type())
+	}
+	self.sendTransformed(notification)
+}
+
+/*
+sendTransformed Queue the INotification, if the queue is enabled,
+or have the View notify Observers of it.
+
+While the queue goroutine is still dispatching after the queue was
+disabled, the INotification is queued behind the ones it has left,
+so that INotifications from one goroutine keep their order.
+
+- parameter notification: the INotification to send, its body already transformed
+*/
+func (self *Facade) sendTransformed(notification interfaces.INotification) {
+	if debug.IsDebugMode() {
+		self.checkNotificationBodyType(notification.Name(), notification.Body())
+	}
+
+	self.queueMutex.Lock()
+	if self.queueEnabled || self.queueRunning {
+		self.queue = append(self.queue, notification)
+		self.queuePending++
+		self.queueCond.Broadcast()
+		self.queueMutex.Unlock()
+		return
+	}
+	self.queueMutex.Unlock()
+
+	self.NotifyObservers(notification)
+}
+
+/*
+SendNotificationAwait Create and send an INotification, and wait for its response.
+
+The response is correlated with the request by a generated id:
+
+* The INotification is sent with the given type followed by '#' and the id, such as "create#42".
+
+* The ICommand handling it answers by sending an INotification named after the request followed by AWAIT_RESPONSE_SUFFIX, with the type of the request:
+
+	self.SendNotification(notification.Name()+facade.AWAIT_RESPONSE_SUFFIX, result, notification.Type())
+
+The first such response is delivered on the returned channel, and
+later ones are ignored. If no response is ever sent, the IObserver
+waiting for it stays registered, so only await INotifications
+whose ICommands always respond.
+
+- parameter notificationName: the name of the notification to send
+
+- parameter body: the body of the notification (optional)
+
+- parameter _type: the type of the notification, before the correlation id
+
+- returns: a channel receiving the response INotification
+*/
+func (self *Facade) SendNotificationAwait(notificationName string, body interface{}, _type string) <-chan interfaces.INotification {
+	correlatedType := fmt.Sprintf("%s#%d", _type, awaitSequence.Add(1))
+	responseName := notificationName + AWAIT_RESPONSE_SUFFIX
+	response := make(chan interfaces.INotification, 1)
+
+	awaiter := &observer.Observer{}
+	awaiter.Notify = func(notification interfaces.INotification) {
+		if notification.Type() != correlatedType {
+			return
+		}
+		self.view.RemoveObserver(responseName, awaiter)
+		select {
+		case response <- notification:
+		default:
+		}
+	}
+	awaiter.Context = awaiter
+	self.view.RegisterObserver(responseName, awaiter)
+
+	self.SendNotification(notificationName, body, correlatedType)
+	return response
+}
+
+/*
+EnableNotificationQueue Serialize the dispatch of sent INotifications.
+
+Once enabled, SendNotification only enqueues the INotification
+and returns; a single goroutine dispatches the queue in First
+In/First Out (FIFO) order, so that INotifications sent from
+many goroutines never interleave. INotifications sent while
+handling a queued INotification are enqueued behind it.
+
+NotifyObservers is not affected and still dispatches immediately.
+*/
+func (self *Facade) EnableNotificationQueue() {
+	self.queueMutex.Lock()
+	defer self.queueMutex.Unlock()
+
+	if self.queueEnabled {
+		return
+	}
+	if self.queueCond == nil {
+		self.queueCond = sync.NewCond(&self.queueMutex)
+	}
+	self.queueEnabled = true
+
+	// reuse the queue goroutine if it has not exited since the queue was disabled
+	if !self.queueRunning {
+		self.queueRunning = true
+		go self.dispatchQueue()
+	}
+}
+
+/*
+DisableNotificationQueue Dispatch any queued INotifications, then
+stop the queue so that SendNotification dispatches immediately again.
+
+Returns once the queue goroutine has exited, so it must not be
+called while handling a queued INotification.
+*/
+func (self *Facade) DisableNotificationQueue() {
+	self.queueMutex.Lock()
+	defer self.queueMutex.Unlock()
+
+	if self.queueEnabled {
+		self.queueEnabled = false
+		self.queueCond.Broadcast()
+	}
+	for self.queueRunning {
+		self.queueCond.Wait()
+	}
+}
+
+/*
+DrainNotifications Block until every queued INotification has been dispatched.
+
+Must not be called while handling a queued INotification,
+since the queue cannot drain until that handling returns.
+*/
+func (self *Facade) DrainNotifications() {
+	self.queueMutex.Lock()
+	defer self.queueMutex.Unlock()
+
+	for self.queuePending > 0 {
+		self.queueCond.Wait()
+	}
+}
+
+/*
+dispatchQueue Dispatch queued INotifications until the queue is disabled.
+*/
+func (self *Facade) dispatchQueue() {
+	self.queueMutex.Lock()
+	defer self.queueMutex.Unlock()
+
+	for {
+		for len(self.queue) == 0 && self.queueEnabled {
+			self.queueCond.Wait()
+		}
+		if len(self.queue) == 0 {
+			self.queueRunning = false
+			self.queueCond.Broadcast()
+			return
+		}
+
+		notification := self.queue[0]
+		self.queue = self.queue[1:]
+
+		self.queueMutex.Unlock()
+		self.NotifyObservers(notification)
+		self.queueMutex.Lock()
+
+		self.queuePending--
+		self.queueCond.Broadcast()
+	}
 }
 
 /*
@@ -274,10 +898,218 @@ Usually you should just call sendNotification
 and pass the parameters, never having to
 construct the notification yourself.
 
+Every INotification passes through the middleware chain
+registered with Use before the View notifies its Observers.
+
+Once the outermost NotifyObservers in progress is done, the
+INotifications sent with SendNotificationDeferred are dispatched.
+
 - parameter notification: the INotification to have the View notify Observers of.
 */
 func (self *Facade) NotifyObservers(notification interfaces.INotification) {
-	self.view.NotifyObservers(notification)
+	self.deferredMutex.Lock()
+	self.dispatchDepth++
+	self.deferredMutex.Unlock()
+
+	completed := false
+	defer func() {
+		if !completed {
+			// an Observer panicked: leave the deferred INotifications to the next dispatch
+			self.deferredMutex.Lock()
+			self.dispatchDepth--
+			self.deferredMutex.Unlock()
+		}
+	}()
+
+	self.notifyObservers(notification)
+	completed = true
+	self.finishDispatch()
+}
+
+/*
+notifyObservers Dispatch an INotification through the middleware chain.
+
+- parameter notification: the INotification to have the View notify Observers of.
+*/
+func (self *Facade) notifyObservers(notification interfaces.INotification) {
+	self.middlewareMutex.RLock()
+	middleware := self.middleware
+	self.middlewareMutex.RUnlock()
+
+	self.dispatch(notification, middleware)
+}
+
+/*
+finishDispatch End a NotifyObservers call, dispatching the deferred
+INotifications if it is the outermost one in progress.
+
+INotifications deferred while the deferred ones are dispatched
+are dispatched in turn, before finishDispatch returns.
+*/
+func (self *Facade) finishDispatch() {
+	self.deferredMutex.Lock()
+	for self.dispatchDepth == 1 && len(self.deferred) > 0 {
+		notification := self.deferred[0]
+		self.deferred = self.deferred[1:]
+		self.deferredMutex.Unlock()
+
+		self.notifyObservers(notification)
+
+		self.deferredMutex.Lock()
+	}
+	self.dispatchDepth--
+	self.deferredMutex.Unlock()
+}
+
+/*
+SendNotificationDeferred Create an INotification and send it once the
+dispatch in progress is done.
+
+The INotification is dispatched after the outermost NotifyObservers
+in progress returns, so after every INotification sent synchronously
+during that dispatch, and after the INotifications deferred before it.
+This keeps an ICommand that sends follow-up INotifications from
+growing the call stack. When several goroutines dispatch at once,
+it waits for all of their dispatches. If no dispatch is in progress,
+the INotification is sent right away with SendNotification.
+
+- parameter notificationName: the name of the notification to send
+
+- parameter body: the body of the notification (optional)
+
+- parameter _type: the type of the notification
+*/
+func (self *Facade) SendNotificationDeferred(notificationName string, body interface{}, _type string) {
+	// transformers run outside of the lock, since they may call back into the Facade
+	body, _ = self.transformBody(notificationName, body)
+	notification := observer.NewNotification(notificationName, body, _type)
+
+	self.deferredMutex.Lock()
+	if self.dispatchDepth == 0 {
+		self.deferredMutex.Unlock()
+		self.sendTransformed(notification)
+		return
+	}
+	defer self.deferredMutex.Unlock()
+
+	if debug.IsDebugMode() {
+		self.checkNotificationBodyType(notificationName, body)
+	}
+	self.deferred = append(self.deferred, notification)
+}
+
+/*
+SendNotificationAfter Create an INotification and send it after a delay.
+
+The INotification is sent with SendNotification from the
+goroutine of a time.AfterFunc. It is cancelled if the returned
+func is called, or the Facade is shut down, before then.
+
+- parameter notificationName: the name of the notification to send
+
+- parameter body: the body of the notification (optional)
+
+- parameter _type: the type of the notification
+
+- parameter delay: how long to wait before sending
+
+- returns: a func cancelling the INotification, reporting whether it was still pending
+*/
+func (self *Facade) SendNotificationAfter(notificationName string, body interface{}, _type string, delay time.Duration) func() bool {
+	self.schedulesMutex.Lock()
+	defer self.schedulesMutex.Unlock()
+
+	if self.schedules == nil {
+		self.schedules = map[uint64]*time.Timer{}
+	}
+	self.scheduleId++
+	var id = self.scheduleId
+	var timer = time.AfterFunc(delay, func() {
+		if self.unschedule(id) {
+			self.SendNotification(notificationName, body, _type)
+		}
+	})
+	self.schedules[id] = timer
+
+	return func() bool {
+		timer.Stop()
+		return self.unschedule(id)
+	}
+}
+
+/*
+unschedule Forget a timer scheduled by SendNotificationAfter.
+
+- parameter id: the id of the scheduled INotification
+
+- returns: whether the INotification was still pending
+*/
+func (self *Facade) unschedule(id uint64) bool {
+	self.schedulesMutex.Lock()
+	defer self.schedulesMutex.Unlock()
+
+	var _, pending = self.schedules[id]
+	delete(self.schedules, id)
+	return pending
+}
+
+/*
+cancelSchedules Cancel every INotification scheduled by SendNotificationAfter.
+*/
+func (self *Facade) cancelSchedules() {
+	self.schedulesMutex.Lock()
+	defer self.schedulesMutex.Unlock()
+
+	for _, timer := range self.schedules {
+		timer.Stop()
+	}
+	self.schedules = nil
+}
+
+/*
+dispatch Run the INotification through the remaining middleware,
+then have the View notify its Observers, and also pass the
+INotification to OnUnhandledNotification if it has none.
+
+The View is notified even then, so that sticky INotifications
+are kept and dispatch metrics are gathered for unhandled names.
+
+- parameter notification: the INotification to dispatch
+
+- parameter middleware: the middleware that has yet to run
+*/
+func (self *Facade) dispatch(notification interfaces.INotification, middleware []func(notification interfaces.INotification, next func())) {
+	if len(middleware) == 0 {
+		unhandled := self.OnUnhandledNotification != nil && !self.view.HasObservers(notification.Name())
+		self.view.NotifyObservers(notification)
+		if unhandled {
+			self.OnUnhandledNotification(notification)
+		}
+		return
+	}
+	middleware[0](notification, func() { self.dispatch(notification, middleware[1:]) })
+}
+
+/*
+Use Register a middleware wrapping the dispatch of every INotification.
+
+Middleware run in registration order for each INotification passed
+to NotifyObservers, including those sent with SendNotification.
+Each one may inspect the INotification and must call next to
+continue the dispatch; not calling next drops the INotification:
+
+	facade.Use(func(notification interfaces.INotification, next func()) {
+	  log.Println(notification.Name())
+	  next()
+	})
+
+- parameter middleware: the func wrapping the rest of the dispatch
+*/
+func (self *Facade) Use(middleware func(notification interfaces.INotification, next func())) {
+	self.middlewareMutex.Lock()
+	defer self.middlewareMutex.Unlock()
+
+	self.middleware = append(self.middleware, middleware)
 }
 
 /*
@@ -289,3 +1121,90 @@ GetInstance when it is invoked.
 func (self *Facade) InitializeNotifier() {
 
 }
+
+/*
+RegisterNotificationBodyType Record the type of the body INotifications with a name must carry.
+
+In debug mode, SendNotification panics with a descriptive
+message when the body of such an INotification is not of the
+same concrete type as the sample, catching a sender and its
+ICommands or IMediators drifting apart before the receiver's
+type assertion fails. Outside of debug mode the body is not checked.
+
+- parameter notificationName: the name of the INotification
+
+- parameter sample: a value of the expected body type, such as &LoginVO{}
+*/
+func (self *Facade) RegisterNotificationBodyType(notificationName string, sample interface{}) {
+	self.bodyTypesMutex.Lock()
+	defer self.bodyTypesMutex.Unlock()
+
+	if self.bodyTypes == nil {
+		self.bodyTypes = map[string]reflect.Type{}
+	}
+	self.bodyTypes[notificationName] = reflect.TypeOf(sample)
+}
+
+/*
+RegisterBodyTransformer Register a func rewriting the body of
+INotifications with a particular name before they are sent.
+
+Transformers run as the INotification is sent, before the body
+type is checked in debug mode and before any IObserver sees it,
+so legacy bodies can be upgraded on the fly. Several transformers
+for the same name are chained in registration order. The
+transformed body is sent in a new INotification, leaving the
+INotification given to SendNotifications as is.
+
+- parameter notificationName: the name of the INotification
+
+- parameter fn: the func returning the body to send in place of the given one
+*/
+func (self *Facade) RegisterBodyTransformer(notificationName string, fn func(body interface{}) interface{}) {
+	self.bodyTransformersMutex.Lock()
+	defer self.bodyTransformersMutex.Unlock()
+
+	if self.bodyTransformers == nil {
+		self.bodyTransformers = map[string][]func(body interface{}) interface{}{}
+	}
+	transformers := self.bodyTransformers[notificationName]
+	self.bodyTransformers[notificationName] = append(transformers[:len(transformers):len(transformers)], fn)
+}
+
+/*
+transformBody Run the body of an INotification through the
+transformers registered for its name.
+
+- parameter notificationName: the name of the INotification
+
+- parameter body: the body of the INotification
+
+- returns: the transformed body, and whether any transformer ran
+*/
+func (self *Facade) transformBody(notificationName string, body interface{}) (interface{}, bool) {
+	self.bodyTransformersMutex.RLock()
+	transformers := self.bodyTransformers[notificationName]
+	self.bodyTransformersMutex.RUnlock()
+
+	for _, transform := range transformers {
+		body = transform(body)
+	}
+	return body, len(transformers) > 0
+}
+
+/*
+checkNotificationBodyType Panic if the body does not have the type registered for the INotification name.
+
+- parameter notificationName: the name of the INotification
+
+- parameter body: the body of the INotification
+*/
+func (self *Facade) checkNotificationBodyType(notificationName string, body interface{}) {
+	self.bodyTypesMutex.RLock()
+	expected, ok := self.bodyTypes[notificationName]
+	self.bodyTypesMutex.RUnlock()
+
+	if actual := reflect.TypeOf(body); ok && actual != expected {
+		panic(fmt.Sprintf("puremvc: notification %q sent with a body of type %v, expecting %v", notificationName, actual, expected))
+	}
+}
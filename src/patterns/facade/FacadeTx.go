@@ -0,0 +1,77 @@
+//
+//  FacadeTx.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+facadeTx An IFacadeTx recording the registrations made
+within a Facade.Transaction.
+*/
+type facadeTx struct {
+	facade    *Facade  // The Facade registering
+	commands  []string // Names of the INotifications mapped to ICommands
+	proxies   []string // Names of the IProxies registered
+	mediators []string // Names of the IMediators registered
+}
+
+/*
+RegisterCommand Register an ICommand with the Controller, recording it.
+
+- parameter notificationName: the name of the INotification to associate the ICommand with.
+
+- parameter factory: reference that returns ICommand
+*/
+func (self *facadeTx) RegisterCommand(notificationName string, factory func() interfaces.ICommand) {
+	self.facade.RegisterCommand(notificationName, factory)
+	self.commands = append(self.commands, notificationName)
+}
+
+/*
+RegisterProxy Register an IProxy with the Model, recording it.
+
+- parameter proxy: the IProxy to be registered with the Model.
+*/
+func (self *facadeTx) RegisterProxy(proxy interfaces.IProxy) {
+	self.facade.RegisterProxy(proxy)
+	// HasProxy, unlike RetrieveProxy, does not count as an access to the IProxy
+	if self.facade.HasProxy(proxy.GetProxyName()) {
+		self.proxies = append(self.proxies, proxy.GetProxyName())
+	}
+}
+
+/*
+RegisterMediator Register an IMediator with the View, recording it
+unless another IMediator was already registered under its name.
+
+- parameter mediator: a reference to the IMediator
+*/
+func (self *facadeTx) RegisterMediator(mediator interfaces.IMediator) {
+	self.facade.RegisterMediator(mediator)
+	if self.facade.RetrieveMediator(mediator.GetMediatorName()) == mediator {
+		self.mediators = append(self.mediators, mediator.GetMediatorName())
+	}
+}
+
+/*
+rollback Remove everything registered within the transaction,
+in the reverse order of registration per kind: IMediators
+first, then IProxies, then ICommands.
+*/
+func (self *facadeTx) rollback() {
+	for i := len(self.mediators) - 1; i >= 0; i-- {
+		self.facade.RemoveMediator(self.mediators[i])
+	}
+	for i := len(self.proxies) - 1; i >= 0; i-- {
+		self.facade.RemoveProxy(self.proxies[i])
+	}
+	for i := len(self.commands) - 1; i >= 0; i-- {
+		self.facade.RemoveCommand(self.commands[i])
+	}
+}
@@ -0,0 +1,29 @@
+//
+//  FuncCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+funcCommand An ICommand executing a handler func,
+registered by Facade.RegisterCommandFunc.
+*/
+type funcCommand struct {
+	Notifier
+	handler func(notification interfaces.INotification) // The func handling the INotification
+}
+
+/*
+Execute Pass the INotification to the handler.
+
+- parameter notification: the INotification to handle.
+*/
+func (self *funcCommand) Execute(notification interfaces.INotification) {
+	self.handler(notification)
+}
@@ -8,7 +8,12 @@
 
 package facade
 
-import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+import (
+	"fmt"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"reflect"
+)
 
 /*
 Notifier A Base INotifier implementation.
@@ -42,8 +47,14 @@ SendNotification   Create and send an INotification.
 	- parameter body: the body of the notification (optional)
 
 	- parameter type: the _type of the notification
+
+	In debug mode, sending before InitializeNotifier has been called
+	panics with a descriptive message rather than a nil dereference.
 */
 func (self *Notifier) SendNotification(notificationName string, body interface{}, _type string) {
+	if debug.IsDebugMode() && self.Facade == nil {
+		panic(fmt.Sprintf("puremvc: notification %q sent before InitializeNotifier; a Notifier cannot send from its constructor", notificationName))
+	}
 	self.Facade.SendNotification(notificationName, body, _type)
 }
 
@@ -65,3 +76,46 @@ yet have been called.
 func (self *Notifier) InitializeNotifier() {
 	self.Facade = GetInstance(func() interfaces.IFacade { return &Facade{} })
 }
+
+/*
+InitializeNotifierWith Initialize this INotifier instance with a particular IFacade.
+
+Called instead of InitializeNotifier by the Core actors of a
+Facade created with NewFacadeWith, so that INotifications sent
+through this Notifier reach that Facade.
+
+- parameter facade: the IFacade to send INotifications to
+*/
+func (self *Notifier) InitializeNotifierWith(facade interfaces.IFacade) {
+	self.Facade = facade
+}
+
+/*
+BodyAs  Assign the body of an INotification to a target, if the types match.
+
+A panic-free alternative to asserting the body type:
+
+	var vo *LoginVO
+	if !self.BodyAs(notification, &vo) {
+	  return
+	}
+
+- parameter notification: the INotification carrying the body
+
+- parameter target: a pointer to a variable of the expected body type
+
+- returns: whether the body was assigned; false for a nil body, a body of another type, or a target that is not a non-nil pointer
+*/
+func (self *Notifier) BodyAs(notification interfaces.INotification, target interface{}) bool {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		return false
+	}
+
+	body := reflect.ValueOf(notification.Body())
+	if !body.IsValid() || !body.Type().AssignableTo(targetValue.Elem().Type()) {
+		return false
+	}
+	targetValue.Elem().Set(body)
+	return true
+}
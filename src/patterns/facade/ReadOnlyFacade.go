@@ -0,0 +1,93 @@
+//
+//  ReadOnlyFacade.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+ReadOnlyFacade A restricted view of an IFacade for plugins.
+
+It can send INotifications and look up Proxies, Mediators and
+Commands, but exposes none of the methods registering or
+removing them, so code holding only a ReadOnlyFacade cannot
+alter the registrations of the host application.
+
+Get one with Facade.ReadOnly.
+*/
+type ReadOnlyFacade struct {
+	facade interfaces.IFacade // The IFacade the calls are delegated to
+}
+
+/*
+SendNotification Create and send an INotification.
+
+- parameter notificationName: the name of the notiification to send
+
+- parameter body: the body of the notification (optional)
+
+- parameter _type: the type of the notification
+*/
+func (self *ReadOnlyFacade) SendNotification(notificationName string, body interface{}, _type string) {
+	self.facade.SendNotification(notificationName, body, _type)
+}
+
+/*
+RetrieveProxy Retrieve an IProxy from the Model by name.
+
+- parameter proxyName: the name of the proxy to be retrieved.
+
+- returns: the IProxy instance previously registered with the given proxyName.
+*/
+func (self *ReadOnlyFacade) RetrieveProxy(proxyName string) interfaces.IProxy {
+	return self.facade.RetrieveProxy(proxyName)
+}
+
+/*
+HasProxy Check if a Proxy is registered
+
+- parameter proxyName:
+
+- returns: whether a Proxy is currently registered with the given proxyName.
+*/
+func (self *ReadOnlyFacade) HasProxy(proxyName string) bool {
+	return self.facade.HasProxy(proxyName)
+}
+
+/*
+RetrieveMediator Retrieve an IMediator from the View.
+
+- parameter mediatorName:
+
+- returns: the IMediator previously registered with the given mediatorName.
+*/
+func (self *ReadOnlyFacade) RetrieveMediator(mediatorName string) interfaces.IMediator {
+	return self.facade.RetrieveMediator(mediatorName)
+}
+
+/*
+HasMediator Check if a Mediator is registered or not
+
+- parameter mediatorName:
+
+- returns: whether a Mediator is registered with the given mediatorName.
+*/
+func (self *ReadOnlyFacade) HasMediator(mediatorName string) bool {
+	return self.facade.HasMediator(mediatorName)
+}
+
+/*
+HasCommand Check if a Command is registered for a given Notification
+
+- parameter notificationName:
+
+- returns: whether a Command is currently registered for the given notificationName.
+*/
+func (self *ReadOnlyFacade) HasCommand(notificationName string) bool {
+	return self.facade.HasCommand(notificationName)
+}
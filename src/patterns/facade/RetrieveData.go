@@ -0,0 +1,36 @@
+//
+//  RetrieveData.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+RetrieveData Retrieve the data of an IProxy by name as a D.
+
+Replaces the chained type assertions on a retrieved IProxy:
+
+	if user, ok := facade.RetrieveData[*UserVO](f, UserProxyName); ok {
+	  fmt.Println(user.Name)
+	}
+
+- parameter f: the IFacade whose Model holds the IProxy
+
+- parameter name: the name of the IProxy
+
+- returns: the data of the IProxy, and whether it is registered and its data is a D; the zero D and false otherwise
+*/
+func RetrieveData[D any](f interfaces.IFacade, name string) (D, bool) {
+	var zero D
+	data, ok := f.RetrieveProxyData(name)
+	if !ok {
+		return zero, false
+	}
+	value, ok := data.(D)
+	return value, ok
+}
@@ -0,0 +1,84 @@
+//
+//  CompositeMediator.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package mediator
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+CompositeMediator A base IMediator implementation that owns child IMediators.
+
+The children are registered with the Facade when the
+CompositeMediator is registered, and removed when it is removed:
+
+	parent := &mediator.CompositeMediator{Mediator: mediator.Mediator{Name: FORM}}
+	parent.AddChild(NewFieldMediator(NAME_FIELD))
+	parent.AddChild(NewFieldMediator(EMAIL_FIELD))
+	facade.RegisterMediator(parent)
+
+A child whose name is already registered when the parent is
+registered is left alone: it is neither registered again nor
+removed with the parent. Neither is an IMediator registered
+under a child's name after the child was removed.
+
+A subclass overriding OnRegister or OnRemove must call
+the CompositeMediator's implementation.
+*/
+type CompositeMediator struct {
+	Mediator
+	children []interfaces.IMediator // the child IMediators
+	owned    []interfaces.IMediator // the children registered by this CompositeMediator
+}
+
+/*
+AddChild Add a child IMediator.
+
+Children must be added before the CompositeMediator is registered.
+
+- parameter child: the child IMediator
+*/
+func (self *CompositeMediator) AddChild(child interfaces.IMediator) {
+	self.children = append(self.children, child)
+}
+
+/*
+Children Get the child IMediators.
+
+- returns: the child IMediators in the order they were added
+*/
+func (self *CompositeMediator) Children() []interfaces.IMediator {
+	children := make([]interfaces.IMediator, len(self.children))
+	copy(children, self.children)
+	return children
+}
+
+/*
+OnRegister Register the children that are not registered yet.
+*/
+func (self *CompositeMediator) OnRegister() {
+	for _, child := range self.children {
+		if self.Facade.HasMediator(child.GetMediatorName()) {
+			continue
+		}
+		self.Facade.RegisterMediator(child)
+		self.owned = append(self.owned, child)
+	}
+}
+
+/*
+OnRemove Remove the children registered by OnRegister
+that are still registered.
+*/
+func (self *CompositeMediator) OnRemove() {
+	for _, child := range self.owned {
+		if self.Facade.RetrieveMediator(child.GetMediatorName()) == child {
+			self.Facade.RemoveMediator(child.GetMediatorName())
+		}
+	}
+	self.owned = nil
+}
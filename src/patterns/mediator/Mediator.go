@@ -49,6 +49,10 @@ func (self *Mediator) SetViewComponent(viewComponent interface{}) {
 ListNotificationInterests List the INotification names this
 Mediator is interested in being notified of.
 
+The default implementation returns an empty list, so a
+Mediator with no interests need not override it; the View
+creates no Observers for such a Mediator.
+
 - returns: Array the list of INotification names
 */
 func (self *Mediator) ListNotificationInterests() []string {
@@ -61,6 +65,9 @@ HandleNotification Handle INotifications.
 Typically, this will be handled in a switch statement,
 with one 'case' entry per INotification
 the Mediator is interested in.
+
+The default implementation does nothing, so a Mediator that
+only cares about its lifecycle hooks need not override it.
 */
 func (self *Mediator) HandleNotification(notification interfaces.INotification) {
 
@@ -0,0 +1,82 @@
+//
+//  MediatorRouter.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package mediator
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+MediatorRouter A base IMediator implementation that routes
+INotifications to handlers registered by name.
+
+Instead of overriding HandleNotification with a switch
+statement and ListNotificationInterests with a matching
+list of names, embed MediatorRouter and register one
+handler per INotification name:
+
+	type MyMediator struct {
+	  mediator.MediatorRouter
+	}
+
+	func NewMyMediator() *MyMediator {
+	  m := &MyMediator{mediator.MediatorRouter{Mediator: mediator.Mediator{Name: NAME}}}
+	  m.Route(LOGIN, m.onLogin)
+	  m.Route(LOGOUT, m.onLogout)
+	  return m
+	}
+
+Handlers must be routed before the mediator is registered
+with the View, since the View interrogates the mediator for
+its interests at registration time.
+*/
+type MediatorRouter struct {
+	Mediator
+	routes   map[string]func(notification interfaces.INotification) // Mapping of Notification names to handlers
+	interest []string                                               // Notification names in the order they were routed
+}
+
+/*
+Route Register a handler for INotifications with the given name.
+
+Routing a name that already has a handler replaces the handler.
+
+- parameter notificationName: the name of the INotification to handle
+
+- parameter handler: the func invoked with the INotification
+*/
+func (self *MediatorRouter) Route(notificationName string, handler func(notification interfaces.INotification)) {
+	if self.routes == nil {
+		self.routes = map[string]func(notification interfaces.INotification){}
+	}
+	if self.routes[notificationName] == nil {
+		self.interest = append(self.interest, notificationName)
+	}
+	self.routes[notificationName] = handler
+}
+
+/*
+ListNotificationInterests List the INotification names that have a routed handler.
+
+- returns: the routed INotification names in the order they were routed
+*/
+func (self *MediatorRouter) ListNotificationInterests() []string {
+	interests := make([]string, len(self.interest))
+	copy(interests, self.interest)
+	return interests
+}
+
+/*
+HandleNotification Dispatch the INotification to the handler routed for its name.
+
+- parameter notification: the INotification to be handled
+*/
+func (self *MediatorRouter) HandleNotification(notification interfaces.INotification) {
+	if handler := self.routes[notification.Name()]; handler != nil {
+		handler(notification)
+	}
+}
@@ -0,0 +1,29 @@
+//
+//  ViewComponent.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package mediator
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+ViewComponent Get the view component of an IMediator as a T.
+
+Replaces the unchecked type assertion on the view component:
+
+	if widget, ok := mediator.ViewComponent[*MyWidget](self); ok {
+	  widget.Refresh()
+	}
+
+- parameter mediator: the IMediator holding the view component
+
+- returns: the view component, and whether it is a T; the zero T and false otherwise
+*/
+func ViewComponent[T any](mediator interfaces.IMediator) (T, bool) {
+	viewComponent, ok := mediator.GetViewComponent().(T)
+	return viewComponent, ok
+}
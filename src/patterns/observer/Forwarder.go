@@ -0,0 +1,45 @@
+//
+//  Forwarder.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+NewForwarder Create an IObserver that re-sends every INotification
+it is notified of under another name.
+
+This is useful to remap a legacy INotification name to a new one,
+for instance during a migration:
+
+	view.RegisterObserver(LEGACY_EVENT, observer.NewForwarder(facade, NEW_EVENT))
+
+The body and type of the INotification are preserved. An INotification
+that already carries the target name is not forwarded again, so
+registering the forwarder for its own target cannot loop forever.
+
+The returned IObserver is its own notify context, so it can be
+removed with view.RemoveObserver(LEGACY_EVENT, forwarder).
+
+- parameter facade: the IFacade used to send the forwarded INotification
+
+- parameter targetName: the name to forward INotifications under
+
+- returns: the forwarding IObserver
+*/
+func NewForwarder(facade interfaces.IFacade, targetName string) interfaces.IObserver {
+	forwarder := &Observer{}
+	forwarder.Notify = func(notification interfaces.INotification) {
+		if notification.Name() == targetName {
+			return
+		}
+		facade.SendNotification(targetName, notification.Body(), notification.Type())
+	}
+	forwarder.Context = forwarder
+	return forwarder
+}
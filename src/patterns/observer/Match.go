@@ -0,0 +1,41 @@
+//
+//  Match.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+Match Build a predicate matching INotifications by name, type and body.
+
+The predicate can assert on captured INotifications in tests,
+or filter the INotifications delivered to an IObserver:
+
+	view.RegisterObserverFiltered(READING, obs, observer.Match(READING, "", func(body interface{}) bool {
+	  return body.(int) > 30
+	}))
+
+- parameter name: the name the INotification must have
+
+- parameter _type: the type the INotification must have, or "" for any type
+
+- parameter bodyMatcher: the func the body must satisfy, or nil for any body
+
+- returns: the predicate
+*/
+func Match(name string, _type string, bodyMatcher func(body interface{}) bool) func(notification interfaces.INotification) bool {
+	return func(notification interfaces.INotification) bool {
+		if notification == nil || notification.Name() != name {
+			return false
+		}
+		if _type != "" && notification.Type() != _type {
+			return false
+		}
+		return bodyMatcher == nil || bodyMatcher(notification.Body())
+	}
+}
@@ -0,0 +1,99 @@
+//
+//  Namespace.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"strings"
+)
+
+const NAMESPACE_SEPARATOR = "/"
+
+/*
+Namespace A hierarchical prefix for INotification names.
+
+Modules declaring their INotification names in their own
+Namespace cannot collide with each other's bare names:
+
+	var auth = observer.NewNamespace("auth")
+	var LOGIN = auth.Name("login") // "auth/login"
+
+The Namespace's Prefix can be given to the prefix registrations
+of the View and the Controller to handle every INotification
+of a module.
+*/
+type Namespace struct {
+	prefix string // the Namespace name followed by NAMESPACE_SEPARATOR
+}
+
+/*
+NewNamespace Create a Namespace.
+
+- parameter name: the name of the Namespace
+
+- returns: the Namespace
+*/
+func NewNamespace(name string) Namespace {
+	return Namespace{prefix: name + NAMESPACE_SEPARATOR}
+}
+
+/*
+Name Get the full name of an INotification in the Namespace.
+
+- parameter name: the name of the INotification within the Namespace
+
+- returns: the Namespace prefix followed by the name
+*/
+func (self Namespace) Name(name string) string {
+	return self.prefix + name
+}
+
+/*
+Prefix Get the prefix of the INotification names in the Namespace.
+
+- returns: the Namespace name followed by NAMESPACE_SEPARATOR
+*/
+func (self Namespace) Prefix() string {
+	return self.prefix
+}
+
+/*
+Namespace Create a Namespace nested in this one.
+
+- parameter name: the name of the nested Namespace within this one
+
+- returns: the nested Namespace
+*/
+func (self Namespace) Namespace(name string) Namespace {
+	return NewNamespace(self.Name(name))
+}
+
+/*
+Contains Check if an INotification name belongs to the Namespace,
+or to a Namespace nested in it.
+
+- parameter notificationName: the name of the INotification
+
+- returns: whether the name begins with the Namespace prefix
+*/
+func (self Namespace) Contains(notificationName string) bool {
+	return strings.HasPrefix(notificationName, self.prefix)
+}
+
+/*
+RegisterObserver Register an IObserver to be notified of
+every INotification in the Namespace.
+
+- parameter view: the IView to register the IObserver with
+
+- parameter observer: the IObserver to register
+*/
+func (self Namespace) RegisterObserver(view interfaces.IView, observer interfaces.IObserver) {
+	view.RegisterPrefixObserver(self.prefix, observer)
+}
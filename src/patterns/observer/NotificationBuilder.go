@@ -0,0 +1,77 @@
+//
+//  NotificationBuilder.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+
+/*
+NotificationBuilder A fluent builder for INotifications.
+
+The builder accumulates the optional attributes of an
+INotification before sending it:
+
+	observer.Build(LOGIN).WithBody(credentials).WithType("sso").Send(facade)
+
+The INotification built is identical to one created with
+NewNotification from the same name, body and type.
+*/
+type NotificationBuilder struct {
+	name  string
+	body  interface{}
+	_type string
+}
+
+/*
+Build Start building an INotification.
+
+- parameter name: name of the INotification. (required)
+
+- returns: the NotificationBuilder
+*/
+func Build(name string) *NotificationBuilder {
+	return &NotificationBuilder{name: name}
+}
+
+/*
+WithBody Set the body of the INotification being built.
+
+- returns: the NotificationBuilder
+*/
+func (self *NotificationBuilder) WithBody(body interface{}) *NotificationBuilder {
+	self.body = body
+	return self
+}
+
+/*
+WithType Set the type of the INotification being built.
+
+- returns: the NotificationBuilder
+*/
+func (self *NotificationBuilder) WithType(_type string) *NotificationBuilder {
+	self._type = _type
+	return self
+}
+
+/*
+Notification Create the INotification built so far.
+
+- returns: a new INotification
+*/
+func (self *NotificationBuilder) Notification() interfaces.INotification {
+	return NewNotification(self.name, self.body, self._type)
+}
+
+/*
+Send Create the INotification and have the IFacade notify its observers.
+
+- parameter facade: the IFacade to send the INotification with
+*/
+func (self *NotificationBuilder) Send(facade interfaces.IFacade) {
+	facade.NotifyObservers(self.Notification())
+}
@@ -32,6 +32,23 @@ type Observer struct {
 	Context interface{}
 }
 
+/*
+FromMethod  Create an Observer notifying a method of an interested object.
+
+The receiver is the notification context, so pass the object
+whose method value is given, as in FromMethod(m, m.HandleNotification),
+for RemoveObserver to find the Observer by that object.
+
+- parameter receiver: the interested object
+
+- parameter method: the method of the interested object to notify
+
+- returns: an Observer with the receiver as its context
+*/
+func FromMethod(receiver interface{}, method func(notification interfaces.INotification)) interfaces.IObserver {
+	return &Observer{Notify: method, Context: receiver}
+}
+
 /*
 NotifyObserver  Notify the interested object.
 
@@ -52,6 +69,25 @@ func (self *Observer) CompareNotifyContext(object interface{}) bool {
 	return object == self.Context
 }
 
+/*
+Equals  Compare an IObserver to this Observer.
+
+Two Observers are equal only if they are the same Observer:
+notification methods cannot be told apart reliably, since two
+closures created by the same function literal share their code,
+so Observers notifying the same context through them are still
+distinct registrations. The View removes a given IObserver with
+RemoveObserverInstance by Equals.
+
+- parameter other: the IObserver to compare
+
+- returns: boolean indicating if the IObserver is this Observer
+*/
+func (self *Observer) Equals(other interfaces.IObserver) bool {
+	that, ok := other.(*Observer)
+	return ok && that == self
+}
+
 /*
 SetNotifyMethod  Set the notification method.
 */
@@ -65,3 +101,10 @@ SetNotifyContext  Set the notification context.
 func (self *Observer) SetNotifyContext(notifyContext interface{}) {
 	self.Context = notifyContext
 }
+
+/*
+NotifyContext  Get the notification context.
+*/
+func (self *Observer) NotifyContext() interface{} {
+	return self.Context
+}
@@ -0,0 +1,66 @@
+//
+//  CodecProxy.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package proxy
+
+/*
+CodecProxy A Proxy whose data object is serialized by a pluggable codec.
+
+Where MarshalJSON always encodes JSON, Bytes and Load use the
+Marshal and Unmarshal funcs the CodecProxy was created with,
+so a data object can be persisted with gob, protobuf or any
+other format without the framework depending on it.
+*/
+type CodecProxy struct {
+	Proxy
+	Marshal   func(data interface{}) ([]byte, error)  // encodes the data object
+	Unmarshal func(input []byte) (interface{}, error) // decodes a data object
+}
+
+/*
+NewCodecProxy Create a CodecProxy.
+
+- parameter name: the proxy name
+
+- parameter marshal: the func encoding the data object
+
+- parameter unmarshal: the func decoding a data object
+
+- returns: the CodecProxy
+*/
+func NewCodecProxy(name string, marshal func(data interface{}) ([]byte, error), unmarshal func(input []byte) (interface{}, error)) *CodecProxy {
+	return &CodecProxy{Proxy: Proxy{Name: name}, Marshal: marshal, Unmarshal: unmarshal}
+}
+
+/*
+Bytes Encode the data object with the Marshal func.
+
+- returns: the encoded data object, or the error of the Marshal func
+*/
+func (self *CodecProxy) Bytes() ([]byte, error) {
+	return self.Marshal(self.GetData())
+}
+
+/*
+Load Decode a data object with the Unmarshal func, and set it.
+
+The data object is set with SetData, so that its observers
+are notified; it is left unchanged if decoding fails.
+
+- parameter input: the encoded data object
+
+- returns: the error of the Unmarshal func, if any
+*/
+func (self *CodecProxy) Load(input []byte) error {
+	data, err := self.Unmarshal(input)
+	if err != nil {
+		return err
+	}
+	self.SetData(data)
+	return nil
+}
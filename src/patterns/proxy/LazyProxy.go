@@ -0,0 +1,77 @@
+//
+//  LazyProxy.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package proxy
+
+import "sync"
+
+/*
+LazyProxy A Proxy whose data object is loaded on first access.
+
+The loader is called by the first GetData, and its result is
+cached for every later call, so an expensive data object is
+only built if it is used. Concurrent first calls to GetData
+wait for a single call of the loader.
+*/
+type LazyProxy struct {
+	Proxy
+	loader func() interface{} // builds the data object
+	once   sync.Once          // guards the call of the loader
+}
+
+/*
+NewLazyProxy Create a LazyProxy.
+
+- parameter name: the proxy name
+
+- parameter loader: the func building the data object on first access
+
+- returns: the LazyProxy
+*/
+func NewLazyProxy(name string, loader func() interface{}) *LazyProxy {
+	return &LazyProxy{Proxy: Proxy{Name: name}, loader: loader}
+}
+
+/*
+GetData Get the data object, loading it on first access
+*/
+func (self *LazyProxy) GetData() interface{} {
+	self.once.Do(func() {
+		self.Data = self.loader()
+	})
+	return self.Data
+}
+
+/*
+SetData Set the data object
+
+The loader is not called if the data object is set before it was loaded.
+*/
+func (self *LazyProxy) SetData(data interface{}) {
+	self.once.Do(func() {})
+	self.Proxy.SetData(data)
+}
+
+/*
+MarshalJSON Serialize the name and the data object of the Proxy, loading the data object first.
+*/
+func (self *LazyProxy) MarshalJSON() ([]byte, error) {
+	self.GetData()
+	return self.Proxy.MarshalJSON()
+}
+
+/*
+UnmarshalJSON Restore the name and the data object of the Proxy.
+
+The data object is loaded first, so that it is decoded into
+the type the loader builds, and is not replaced by the loader later.
+*/
+func (self *LazyProxy) UnmarshalJSON(input []byte) error {
+	self.GetData()
+	return self.Proxy.UnmarshalJSON(input)
+}
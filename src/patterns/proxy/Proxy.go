@@ -8,7 +8,12 @@
 
 package proxy
 
-import "github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"reflect"
+)
 
 const NAME = "Proxy" // default name for the proxy
 
@@ -30,8 +35,9 @@ when the Proxy has retrieved the data from the service.
 */
 type Proxy struct {
 	facade.Notifier
-	Name string      // the proxy name
-	Data interface{} // the data object
+	Name         string                     // the proxy name
+	Data         interface{}                // the data object
+	dataObserver func(old, new interface{}) // set by the Model while the proxy is registered
 }
 
 /*
@@ -43,9 +49,25 @@ func (self *Proxy) GetProxyName() string {
 
 /*
 SetData Set the data object
+
+If the Proxy is registered with the Model, the Model
+notifies the observers of the Proxy of the change.
 */
 func (self *Proxy) SetData(data interface{}) {
+	var old = self.Data
 	self.Data = data
+	if self.dataObserver != nil {
+		self.dataObserver(old, data)
+	}
+}
+
+/*
+SetDataObserver Set the func called when the data object changes.
+
+Called by the Model when the Proxy is registered and removed.
+*/
+func (self *Proxy) SetDataObserver(observer func(old, new interface{})) {
+	self.dataObserver = observer
 }
 
 /*
@@ -57,6 +79,9 @@ func (self *Proxy) GetData() interface{} {
 
 /*
 OnRegister Called by the Model when the Proxy is registered
+
+The Model calls it before the Proxy becomes retrievable, so
+data initialized here is seen by every goroutine retrieving it.
 */
 func (self *Proxy) OnRegister() {
 
@@ -68,3 +93,105 @@ OnRemove Called by the Model when the Proxy is removed
 func (self *Proxy) OnRemove() {
 
 }
+
+/*
+proxyJSON The JSON representation of a Proxy.
+*/
+type proxyJSON struct {
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data"`
+}
+
+/*
+MarshalJSON Serialize the name and the data object of the Proxy.
+
+The data object must itself be JSON-serializable.
+
+- returns: the JSON encoding of the Proxy
+*/
+func (self *Proxy) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(self.Data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(proxyJSON{Name: self.Name, Data: data})
+}
+
+/*
+UnmarshalJSON Restore the name and the data object of the Proxy.
+
+The name is restored only if the Proxy has none, so that a
+registered Proxy keeps the name the Model holds it under;
+an encoding of a Proxy with another name is an error.
+The data object is decoded into a value of the same type as
+the current data object, so set a zero value of the expected
+type first; if the data object is nil, it is decoded into
+generic maps, slices and values. The data object is set with
+SetData, so that its observers are notified.
+
+- parameter input: the JSON encoding of a Proxy
+*/
+func (self *Proxy) UnmarshalJSON(input []byte) error {
+	var decoded proxyJSON
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return err
+	}
+
+	name, err := restoredName(self.Name, decoded.Name)
+	if err != nil {
+		return err
+	}
+	data, err := decodeLike(self.Data, decoded.Data)
+	if err != nil {
+		return err
+	}
+	self.Name = name
+	self.SetData(data)
+	return nil
+}
+
+/*
+restoredName Get the name of a Proxy restored from JSON.
+
+- parameter current: the name of the Proxy, or "" if it has none
+
+- parameter decoded: the name in the JSON encoding
+
+- returns: the name to keep, or an error if the Proxy already has another name
+*/
+func restoredName(current string, decoded string) (string, error) {
+	if current == "" {
+		return decoded, nil
+	}
+	if decoded != current {
+		return "", fmt.Errorf("proxy %q cannot be restored from proxy %q", current, decoded)
+	}
+	return current, nil
+}
+
+/*
+decodeLike Decode JSON into a value of the same type as a sample.
+
+- parameter sample: a value of the type to decode into, or nil for generic values
+
+- parameter input: the JSON encoding of the value
+
+- returns: the decoded value
+*/
+func decodeLike(sample interface{}, input []byte) (interface{}, error) {
+	if sample == nil {
+		var value interface{}
+		err := json.Unmarshal(input, &value)
+		return value, err
+	}
+
+	var sampleType = reflect.TypeOf(sample)
+	if sampleType.Kind() == reflect.Ptr {
+		var value = reflect.New(sampleType.Elem())
+		err := json.Unmarshal(input, value.Interface())
+		return value.Interface(), err
+	}
+	var value = reflect.New(sampleType)
+	err := json.Unmarshal(input, value.Interface())
+	return value.Elem().Interface(), err
+}
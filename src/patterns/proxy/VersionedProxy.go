@@ -0,0 +1,184 @@
+//
+//  VersionedProxy.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package proxy
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+/*
+VersionedProxy A Proxy whose data object carries a version,
+for optimistic concurrency control.
+
+The version starts at 0 and is incremented by each SetData.
+A writer reads the data object along with its version with
+GetDataVersion, computes the new data object, and applies it
+with CompareAndSetData, which fails if another writer updated
+the data object in between. Access to the data object and its
+version is synchronized; use GetData and SetData rather than
+the Data field.
+*/
+type VersionedProxy struct {
+	Proxy
+	version int        // the number of updates of the data object
+	mutex   sync.Mutex // guards Name, Data, version and the data observer
+}
+
+/*
+NewVersionedProxy Create a VersionedProxy.
+
+- parameter name: the proxy name
+
+- parameter data: the initial data object, at version 0
+
+- returns: the VersionedProxy
+*/
+func NewVersionedProxy(name string, data interface{}) *VersionedProxy {
+	return &VersionedProxy{Proxy: Proxy{Name: name, Data: data}}
+}
+
+/*
+GetData Get the data object
+*/
+func (self *VersionedProxy) GetData() interface{} {
+	data, _ := self.GetDataVersion()
+	return data
+}
+
+/*
+GetDataVersion Get the data object and its version, consistently.
+
+- returns: the data object, and its version
+*/
+func (self *VersionedProxy) GetDataVersion() (interface{}, int) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return self.Data, self.version
+}
+
+/*
+Version Get the version of the data object
+*/
+func (self *VersionedProxy) Version() int {
+	_, version := self.GetDataVersion()
+	return version
+}
+
+/*
+SetData Set the data object, whatever its version
+*/
+func (self *VersionedProxy) SetData(data interface{}) {
+	self.update(data, func() bool { return true })
+}
+
+/*
+CompareAndSetData Set the data object if it is still at the expected version.
+
+- parameter expectedVersion: the version the data object was read at
+
+- parameter data: the new data object
+
+- returns: whether the version matched and the data object was set
+*/
+func (self *VersionedProxy) CompareAndSetData(expectedVersion int, data interface{}) bool {
+	return self.update(data, func() bool { return self.version == expectedVersion })
+}
+
+/*
+SetDataObserver Set the func called when the data object changes.
+*/
+func (self *VersionedProxy) SetDataObserver(observer func(old, new interface{})) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.dataObserver = observer
+}
+
+/*
+update Set the data object and increment its version if the condition holds.
+
+The data observer is called after the lock is released,
+so that it may read the VersionedProxy.
+
+- parameter data: the new data object
+
+- parameter condition: checked under the lock before updating
+
+- returns: whether the data object was set
+*/
+func (self *VersionedProxy) update(data interface{}, condition func() bool) bool {
+	self.mutex.Lock()
+	if !condition() {
+		self.mutex.Unlock()
+		return false
+	}
+	var old = self.Data
+	self.Data = data
+	self.version++
+	var observer = self.dataObserver
+	self.mutex.Unlock()
+
+	if observer != nil {
+		observer(old, data)
+	}
+	return true
+}
+
+/*
+MarshalJSON Serialize the name and the data object of the VersionedProxy,
+reading the data object under the lock.
+
+- returns: the JSON encoding of the VersionedProxy
+*/
+func (self *VersionedProxy) MarshalJSON() ([]byte, error) {
+	self.mutex.Lock()
+	var name, data = self.Name, self.Data
+	self.mutex.Unlock()
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(proxyJSON{Name: name, Data: encoded})
+}
+
+/*
+UnmarshalJSON Restore the name and the data object of the VersionedProxy.
+
+The name and the data object are decoded as Proxy.UnmarshalJSON
+decodes them, then the data object is set as SetData sets it,
+so its version is incremented.
+
+- parameter input: the JSON encoding of a Proxy
+*/
+func (self *VersionedProxy) UnmarshalJSON(input []byte) error {
+	var decoded proxyJSON
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return err
+	}
+
+	self.mutex.Lock()
+	name, err := restoredName(self.Name, decoded.Name)
+	self.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	data, err := decodeLike(self.GetData(), decoded.Data)
+	if err != nil {
+		return err
+	}
+	self.mutex.Lock()
+	self.Name = name
+	self.mutex.Unlock()
+
+	self.SetData(data)
+	return nil
+}
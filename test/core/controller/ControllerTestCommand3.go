@@ -0,0 +1,35 @@
+//
+//  ControllerTestCommand3.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+ControllerTestCommand3 A SimpleCommand subclass used by ControllerTest.
+*/
+type ControllerTestCommand3 struct {
+	command.SimpleCommand
+}
+
+/*
+Execute  Fabricate a result by multiplying the input by 3 and adding to the existing result
+
+This tests accumulation effect that would show if the command were executed more than once.
+
+- parameter note: the note carrying the ControllerTestVO
+*/
+func (controller *ControllerTestCommand3) Execute(notification interfaces.INotification) {
+	var vo = notification.Body().(*ControllerTestVO)
+
+	// Fabricate a result
+	vo.Result = vo.Result + (3 * vo.Input)
+}
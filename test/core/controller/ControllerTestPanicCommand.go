@@ -0,0 +1,30 @@
+//
+//  ControllerTestPanicCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+ControllerTestPanicCommand A SimpleCommand subclass used by ControllerTest.
+*/
+type ControllerTestPanicCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Panic with the note's body.
+
+- parameter note: the note carrying the value to panic with
+*/
+func (controller *ControllerTestPanicCommand) Execute(notification interfaces.INotification) {
+	panic(notification.Body())
+}
@@ -0,0 +1,42 @@
+//
+//  ControllerTestPoolCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+	"time"
+)
+
+/*
+ControllerTestPoolCommand A SimpleCommand subclass used by ControllerTest.
+*/
+type ControllerTestPoolCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Record how many commands run at once, while briefly sleeping.
+
+- parameter note: the note carrying the ControllerTestPoolVO
+*/
+func (controller *ControllerTestPoolCommand) Execute(notification interfaces.INotification) {
+	var vo = notification.Body().(*ControllerTestPoolVO)
+
+	running := vo.Running.Add(1)
+	for {
+		max := vo.MaxRunning.Load()
+		if running <= max || vo.MaxRunning.CompareAndSwap(max, running) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	vo.Running.Add(-1)
+	vo.Completed.Add(1)
+}
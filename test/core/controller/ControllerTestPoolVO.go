@@ -0,0 +1,21 @@
+//
+//  ControllerTestPoolVO.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import "sync/atomic"
+
+/*
+ControllerTestPoolVO A utility class used by ControllerTest
+to track the concurrency of asynchronous commands.
+*/
+type ControllerTestPoolVO struct {
+	Running    atomic.Int32
+	MaxRunning atomic.Int32
+	Completed  atomic.Int32
+}
@@ -0,0 +1,32 @@
+//
+//  ControllerTestRegisterCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/controller"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+ControllerTestRegisterCommand A SimpleCommand subclass used by ControllerTest.
+*/
+type ControllerTestRegisterCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute  Register ControllerTestCommand for the note name carried in the body
+
+- parameter note: the note carrying the name to register
+*/
+func (self *ControllerTestRegisterCommand) Execute(notification interfaces.INotification) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand(notification.Body().(string), func() interfaces.ICommand { return &ControllerTestCommand{} })
+}
@@ -0,0 +1,33 @@
+//
+//  ControllerTestRemoveCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+ControllerTestRemoveCommand A SimpleCommand subclass used by ControllerTest.
+*/
+type ControllerTestRemoveCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute  Fabricate a result, then remove this Command's own mapping through the Facade
+
+- parameter note: the note carrying the ControllerTestVO
+*/
+func (self *ControllerTestRemoveCommand) Execute(notification interfaces.INotification) {
+	var vo = notification.Body().(*ControllerTestVO)
+	vo.Result = vo.Result + (2 * vo.Input)
+
+	self.Facade.RemoveCommand(notification.Name())
+}
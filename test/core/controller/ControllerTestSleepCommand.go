@@ -0,0 +1,33 @@
+//
+//  ControllerTestSleepCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package controller
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+	"time"
+)
+
+/*
+ControllerTestSleepCommand A SimpleCommand subclass used by ControllerTest.
+*/
+type ControllerTestSleepCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Sleep for the duration carried in the note.
+
+This tests a command that does not finish in time.
+
+- parameter note: the note carrying the time.Duration to sleep for
+*/
+func (controller *ControllerTestSleepCommand) Execute(notification interfaces.INotification) {
+	time.Sleep(notification.Body().(time.Duration))
+}
@@ -9,11 +9,20 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/controller"
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 /*
@@ -172,3 +181,575 @@ func TestReregisterAndExecuteCommand(t *testing.T) {
 		t.Error("Expecting vo.result == 48")
 	}
 }
+
+/*
+Tests that the MetricsHook is called after a Command executes.
+*/
+func TestMetricsHook(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} }).(*controller.Controller)
+	c.RegisterCommand("ControllerMetricsTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+
+	var calls = 0
+	var name string
+	var duration time.Duration = -1
+	c.MetricsHook = func(notificationName string, d time.Duration) {
+		calls++
+		name = notificationName
+		duration = d
+	}
+	defer func() { c.MetricsHook = nil }()
+
+	// execute the Command, then a note with no Command mapped
+	var vo = ControllerTestVO{Input: 12}
+	c.ExecuteCommand(observer.NewNotification("ControllerMetricsTest", &vo, ""))
+	c.ExecuteCommand(observer.NewNotification("ControllerMetricsUnmapped", &vo, ""))
+
+	// test assertions
+	if calls != 1 {
+		t.Error("Expecting calls == 1")
+	}
+	if name != "ControllerMetricsTest" {
+		t.Error("Expecting name == 'ControllerMetricsTest'")
+	}
+	if duration < 0 {
+		t.Error("Expecting duration >= 0")
+	}
+
+	c.RemoveCommand("ControllerMetricsTest")
+}
+
+/*
+Tests Command registration by Notification name prefix.
+
+An exact mapping wins over a prefix mapping, overlapping
+prefixes resolve to the longest match, and a Notification
+matching neither executes nothing.
+*/
+func TestRegisterCommandPrefix(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	c.RegisterCommand("ControllerPrefix.exact", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	c.RegisterCommandPrefix("ControllerPrefix.", func() interfaces.ICommand { return &ControllerTestCommand2{} })
+	c.RegisterCommandPrefix("ControllerPrefix.admin.", func() interfaces.ICommand { return &ControllerTestCommand3{} })
+
+	var send = func(name string) int {
+		var vo = ControllerTestVO{Input: 12}
+		v.NotifyObservers(observer.NewNotification(name, &vo, ""))
+		return vo.Result
+	}
+
+	// exact match: only ControllerTestCommand runs
+	if result := send("ControllerPrefix.exact"); result != 24 {
+		t.Error("Expecting exact match result == 24, got ", result)
+	}
+
+	// prefix match: only ControllerTestCommand2 runs
+	if result := send("ControllerPrefix.user"); result != 24 {
+		t.Error("Expecting prefix match result == 24, got ", result)
+	}
+
+	// overlapping prefixes: only the longest, ControllerTestCommand3, runs
+	if result := send("ControllerPrefix.admin.created"); result != 36 {
+		t.Error("Expecting longest prefix match result == 36, got ", result)
+	}
+
+	// no match
+	if result := send("ControllerOther.user"); result != 0 {
+		t.Error("Expecting non-match result == 0, got ", result)
+	}
+
+	c.RemoveCommand("ControllerPrefix.exact")
+	c.RemoveCommandPrefix("ControllerPrefix.")
+	c.RemoveCommandPrefix("ControllerPrefix.admin.")
+
+	if result := send("ControllerPrefix.user"); result != 0 {
+		t.Error("Expecting removed prefix result == 0, got ", result)
+	}
+}
+
+/*
+Tests that a Command can register another Command while it executes.
+*/
+func TestRegisterCommandDuringExecute(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerRegisteringTest", func() interfaces.ICommand { return &ControllerTestRegisterCommand{} })
+
+	var done = make(chan bool)
+	go func() {
+		c.ExecuteCommand(observer.NewNotification("ControllerRegisteringTest", "ControllerRegisteredTest", ""))
+		done <- true
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expecting ExecuteCommand to return without deadlock")
+	}
+
+	// test assertions
+	if c.HasCommand("ControllerRegisteredTest") == false {
+		t.Error("Expecting c.HasCommand('ControllerRegisteredTest') == true")
+	}
+
+	c.RemoveCommand("ControllerRegisteringTest")
+	c.RemoveCommand("ControllerRegisteredTest")
+}
+
+/*
+Tests that a Command can remove its own mapping through the Facade while it executes.
+*/
+func TestRemoveCommandDuringExecute(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerSelfRemovingTest", func() interfaces.ICommand { return &ControllerTestRemoveCommand{} })
+
+	var vo = ControllerTestVO{Input: 12}
+	var note = observer.NewNotification("ControllerSelfRemovingTest", &vo, "")
+
+	var done = make(chan bool)
+	go func() {
+		c.ExecuteCommand(note)
+		done <- true
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expecting ExecuteCommand to return without deadlock")
+	}
+
+	// test assertions
+	if c.HasCommand("ControllerSelfRemovingTest") == true {
+		t.Error("Expecting c.HasCommand('ControllerSelfRemovingTest') == false")
+	}
+
+	// the Command is gone, so the result does not accumulate
+	c.ExecuteCommand(note)
+	if vo.Result != 24 {
+		t.Error("Expecting vo.Result == 24")
+	}
+}
+
+/*
+Tests registering two Commands for one Notification,
+and that RegisterCommand replaces them both.
+*/
+func TestRegisterAdditionalCommand(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerAdditionalTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	c.RegisterAdditionalCommand("ControllerAdditionalTest", func() interfaces.ICommand { return &ControllerTestCommand3{} })
+
+	// ControllerTestCommand sets 24, then ControllerTestCommand3 adds 36
+	var vo = ControllerTestVO{Input: 12}
+	view.GetInstance(func() interfaces.IView { return &view.View{} }).NotifyObservers(observer.NewNotification("ControllerAdditionalTest", &vo, ""))
+
+	// test assertions
+	if vo.Result != 60 {
+		t.Error("Expecting vo.Result == 60, got ", vo.Result)
+	}
+
+	// RegisterCommand replaces every registered Command
+	c.RegisterCommand("ControllerAdditionalTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	vo.Result = 0
+	c.ExecuteCommand(observer.NewNotification("ControllerAdditionalTest", &vo, ""))
+	if vo.Result != 24 {
+		t.Error("Expecting vo.Result == 24, got ", vo.Result)
+	}
+
+	c.RemoveCommand("ControllerAdditionalTest")
+}
+
+/*
+Tests executing a Command with a timeout.
+*/
+func TestExecuteCommandTimeout(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerTimeoutTest", func() interfaces.ICommand { return &ControllerTestSleepCommand{} })
+
+	// a command sleeping longer than the timeout
+	var err = c.ExecuteCommandTimeout(observer.NewNotification("ControllerTimeoutTest", 200*time.Millisecond, ""), 10*time.Millisecond)
+	if !errors.Is(err, controller.ErrCommandTimeout) {
+		t.Error("Expecting errors.Is(err, controller.ErrCommandTimeout), got ", err)
+	}
+
+	// a command finishing within the timeout
+	err = c.ExecuteCommandTimeout(observer.NewNotification("ControllerTimeoutTest", time.Duration(0), ""), time.Second)
+	if err != nil {
+		t.Error("Expecting err == nil, got ", err)
+	}
+
+	c.RemoveCommand("ControllerTimeoutTest")
+}
+
+/*
+Tests that a Command registered once executes only for the first Notification.
+*/
+func TestRegisterCommandOnce(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommandOnce("ControllerOnceTest", func() interfaces.ICommand { return &ControllerTestCommand3{} })
+
+	// Send the notification twice
+	var vo = ControllerTestVO{Input: 12}
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	v.NotifyObservers(observer.NewNotification("ControllerOnceTest", &vo, ""))
+	v.NotifyObservers(observer.NewNotification("ControllerOnceTest", &vo, ""))
+
+	// test assertions
+	if vo.Result != 36 {
+		t.Error("Expecting vo.Result == 36, got ", vo.Result)
+	}
+	if c.HasCommand("ControllerOnceTest") {
+		t.Error("Expecting c.HasCommand('ControllerOnceTest') == false")
+	}
+}
+
+/*
+Tests that removing a Command reports whether it was registered.
+*/
+func TestRemoveCommandB(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerRemoveBTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+
+	// test assertions
+	if !c.RemoveCommandB("ControllerRemoveBTest") {
+		t.Error("Expecting c.RemoveCommandB('ControllerRemoveBTest') == true")
+	}
+	if c.RemoveCommandB("ControllerRemoveBTest") {
+		t.Error("Expecting a second c.RemoveCommandB('ControllerRemoveBTest') == false")
+	}
+	if c.RemoveCommandB("ControllerNeverRegistered") {
+		t.Error("Expecting c.RemoveCommandB('ControllerNeverRegistered') == false")
+	}
+}
+
+/*
+Tests hot-swapping a registered Command.
+*/
+func TestReplaceCommand(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+
+	// test assertions
+	if c.ReplaceCommand("ControllerReplaceTest", func() interfaces.ICommand { return &ControllerTestCommand{} }) {
+		t.Error("Expecting hadPrevious == false for a new mapping")
+	}
+	if !c.ReplaceCommand("ControllerReplaceTest", func() interfaces.ICommand { return &ControllerTestCommand3{} }) {
+		t.Error("Expecting hadPrevious == true for an existing mapping")
+	}
+
+	// only the new command runs, once
+	var vo = ControllerTestVO{Input: 12}
+	view.GetInstance(func() interfaces.IView { return &view.View{} }).NotifyObservers(observer.NewNotification("ControllerReplaceTest", &vo, ""))
+	if vo.Result != 36 {
+		t.Error("Expecting vo.Result == 36, got ", vo.Result)
+	}
+
+	c.RemoveCommand("ControllerReplaceTest")
+}
+
+/*
+Tests that OnCommandMapChanged is called when a Command is registered and removed.
+*/
+func TestOnCommandMapChanged(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} }).(*controller.Controller)
+
+	var changes []string
+	c.OnCommandMapChanged = func(notificationName string, added bool) {
+		changes = append(changes, fmt.Sprint(notificationName, " ", added))
+		if c.HasCommand(notificationName) != added {
+			t.Error("Expecting the hook to be called after the command map is updated")
+		}
+	}
+	defer func() { c.OnCommandMapChanged = nil }()
+
+	c.RegisterCommand("ControllerMapChangedTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	c.RemoveCommand("ControllerMapChangedTest")
+	c.RemoveCommand("ControllerMapChangedTest")
+
+	// test assertions
+	if len(changes) != 2 || changes[0] != "ControllerMapChangedTest true" || changes[1] != "ControllerMapChangedTest false" {
+		t.Error("Expecting changes == [ControllerMapChangedTest true, ControllerMapChangedTest false], got ", changes)
+	}
+}
+
+/*
+Tests that a factory returning a nil Command is skipped, and panics clearly in debug mode.
+*/
+func TestNilCommandFactory(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerNilTest", func() interfaces.ICommand { return nil })
+	c.RegisterAdditionalCommand("ControllerNilTest", func() interfaces.ICommand { return (*ControllerTestCommand)(nil) })
+	c.RegisterAdditionalCommand("ControllerNilTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	defer c.RemoveCommand("ControllerNilTest")
+
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	var vo = ControllerTestVO{Input: 12}
+	c.ExecuteCommand(observer.NewNotification("ControllerNilTest", &vo, ""))
+
+	// test assertions
+	if vo.Result != 24 {
+		t.Error("Expecting the nil commands to be skipped and vo.Result == 24")
+	}
+
+	debug.SetDebugMode(true)
+	defer debug.SetDebugMode(false)
+
+	defer func() {
+		var report = recover()
+		if message, ok := report.(string); !ok || !strings.Contains(message, `"ControllerNilTest"`) {
+			t.Error("Expecting a panic naming the notification, got ", report)
+		}
+	}()
+
+	c.ExecuteCommand(observer.NewNotification("ControllerNilTest", &vo, ""))
+}
+
+/*
+Tests that restoring a snapshot reinstates the original Commands.
+*/
+func TestSnapshotAndRestoreCommands(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerSnapshotTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	defer c.RemoveCommand("ControllerSnapshotTest")
+
+	var snapshot = c.SnapshotCommands()
+
+	// swap in a test double and add a new mapping
+	c.RegisterCommand("ControllerSnapshotTest", func() interfaces.ICommand { return &ControllerTestCommand3{} })
+	c.RegisterCommand("ControllerSnapshotAddedTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+
+	c.RestoreCommands(snapshot)
+
+	var vo = ControllerTestVO{Input: 12}
+	c.ExecuteCommand(observer.NewNotification("ControllerSnapshotTest", &vo, ""))
+
+	// test assertions
+	if vo.Result != 24 {
+		t.Error("Expecting the original command to run again and vo.Result == 24, got ", vo.Result)
+	}
+	if c.HasCommand("ControllerSnapshotAddedTest") {
+		t.Error("Expecting the mapping added after the snapshot to be removed")
+	}
+}
+
+/*
+Tests that restoring a snapshot reinstates the asynchronous,
+disabled and once state of the Commands.
+*/
+func TestSnapshotAndRestoreCommandState(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerSnapshotStateTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	c.RegisterCommandAsync("ControllerSnapshotAsyncTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	c.RegisterCommandOnce("ControllerSnapshotOnceTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	c.RegisterCommand("ControllerSnapshotDisabledTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	c.SetCommandEnabled("ControllerSnapshotDisabledTest", false)
+	defer c.RemoveCommand("ControllerSnapshotStateTest")
+	defer c.RemoveCommand("ControllerSnapshotAsyncTest")
+	defer c.RemoveCommand("ControllerSnapshotOnceTest")
+	defer c.RemoveCommand("ControllerSnapshotDisabledTest")
+
+	var snapshot = c.SnapshotCommands()
+
+	// change the state of every mapping
+	c.SetCommandEnabled("ControllerSnapshotStateTest", false)
+	c.SetCommandEnabled("ControllerSnapshotDisabledTest", true)
+	c.RemoveCommand("ControllerSnapshotAsyncTest")
+	c.RegisterCommand("ControllerSnapshotOnceTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+
+	c.RestoreCommands(snapshot)
+	var restored = c.SnapshotCommands()
+
+	// test assertions
+	if restored.Disabled["ControllerSnapshotStateTest"] || !restored.Disabled["ControllerSnapshotDisabledTest"] {
+		t.Error("Expecting the disabled commands to be restored, got ", restored.Disabled)
+	}
+	if !restored.Async["ControllerSnapshotAsyncTest"] || !c.HasCommand("ControllerSnapshotAsyncTest") {
+		t.Error("Expecting the asynchronous command to be restored, got ", restored.Async)
+	}
+	if !restored.Once["ControllerSnapshotOnceTest"] {
+		t.Error("Expecting the once command to be restored, got ", restored.Once)
+	}
+
+	var vo = ControllerTestVO{Input: 12}
+	c.ExecuteCommand(observer.NewNotification("ControllerSnapshotStateTest", &vo, ""))
+	if vo.Result != 24 {
+		t.Error("Expecting the re-enabled command to run and vo.Result == 24, got ", vo.Result)
+	}
+}
+
+/*
+Tests executing a Command instance that is not registered.
+*/
+func TestExecuteCommandInstance(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+
+	var vo = ControllerTestVO{Input: 12}
+	c.ExecuteCommandInstance(&ControllerTestCommand{}, observer.NewNotification("ControllerInstanceTest", &vo, ""))
+
+	// test assertions
+	if vo.Result != 24 {
+		t.Error("Expecting vo.Result == 24")
+	}
+	if c.HasCommand("ControllerInstanceTest") {
+		t.Error("Expecting the command not to be registered")
+	}
+}
+
+/*
+Tests that a disabled command keeps its mapping but is not
+executed until it is enabled again.
+*/
+func TestSetCommandEnabled(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommand("ControllerEnabledTest", func() interfaces.ICommand { return &ControllerTestCommand2{} })
+	defer c.RemoveCommand("ControllerEnabledTest")
+
+	var vo = &ControllerTestVO{Input: 12}
+	var note = observer.NewNotification("ControllerEnabledTest", vo, "")
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	c.SetCommandEnabled("ControllerEnabledTest", false)
+	v.NotifyObservers(note)
+
+	// test assertions
+	if vo.Result != 0 {
+		t.Error("Expecting vo.Result == 0 while the command is disabled")
+	}
+	if !c.HasCommand("ControllerEnabledTest") {
+		t.Error("Expecting the disabled command to stay registered")
+	}
+
+	c.SetCommandEnabled("ControllerEnabledTest", true)
+	v.NotifyObservers(note)
+
+	if vo.Result != 24 {
+		t.Error("Expecting vo.Result == 24 once the command is enabled")
+	}
+}
+
+/*
+Tests that asynchronous commands executed on a worker pool
+all complete, with no more concurrent runners than workers.
+*/
+func TestStressWorkerPool(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.EnableWorkerPool(3)
+	defer c.Shutdown()
+
+	c.RegisterCommandAsync("ControllerPoolTest", func() interfaces.ICommand { return &ControllerTestPoolCommand{} })
+	defer c.RemoveCommand("ControllerPoolTest")
+
+	var vo = &ControllerTestPoolVO{}
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	for i := 0; i < 30; i++ {
+		v.NotifyObservers(observer.NewNotification("ControllerPoolTest", vo, ""))
+	}
+
+	// draining the pool waits for every queued command
+	c.Shutdown()
+
+	// test assertions
+	if vo.Completed.Load() != 30 {
+		t.Error("Expecting vo.Completed == 30, got ", vo.Completed.Load())
+	}
+	if vo.MaxRunning.Load() > 3 {
+		t.Error("Expecting at most 3 concurrent commands, got ", vo.MaxRunning.Load())
+	}
+}
+
+/*
+Tests that Shutdown waits for the asynchronous commands submitted
+while their worker pool is being replaced.
+*/
+func TestStressWorkerPoolReplaced(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.EnableWorkerPool(2)
+	defer c.Shutdown()
+
+	c.RegisterCommandAsync("ControllerPoolReplacedTest", func() interfaces.ICommand { return &ControllerTestPoolCommand{} })
+	defer c.RemoveCommand("ControllerPoolReplacedTest")
+
+	var vo = &ControllerTestPoolVO{}
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	var senders sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		senders.Add(1)
+		go func() {
+			defer senders.Done()
+			for j := 0; j < 25; j++ {
+				v.NotifyObservers(observer.NewNotification("ControllerPoolReplacedTest", vo, ""))
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		c.EnableWorkerPool(2)
+	}
+	senders.Wait()
+
+	c.Shutdown()
+
+	// test assertions
+	if vo.Completed.Load() != 100 {
+		t.Error("Expecting vo.Completed == 100, got ", vo.Completed.Load())
+	}
+}
+
+/*
+Tests that Shutdown waits for asynchronous commands
+executing without a worker pool.
+*/
+func TestStressShutdownWithoutPool(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	c.RegisterCommandAsync("ControllerNoPoolTest", func() interfaces.ICommand { return &ControllerTestPoolCommand{} })
+	defer c.RemoveCommand("ControllerNoPoolTest")
+
+	var vo = &ControllerTestPoolVO{}
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	for i := 0; i < 10; i++ {
+		v.NotifyObservers(observer.NewNotification("ControllerNoPoolTest", vo, ""))
+	}
+
+	c.Shutdown()
+
+	// test assertions
+	if vo.Completed.Load() != 10 {
+		t.Error("Expecting vo.Completed == 10, got ", vo.Completed.Load())
+	}
+}
+
+/*
+Tests that a panicking command is recovered and handed to the
+CommandErrorHandler, and that the following command still executes.
+*/
+func TestRecoverCommands(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} }).(*controller.Controller)
+
+	var name string
+	var recovered interface{}
+	c.CommandErrorHandler = func(notificationName string, r interface{}) {
+		name = notificationName
+		recovered = r
+	}
+	c.SetRecoverCommands(true)
+	defer func() {
+		c.SetRecoverCommands(false)
+		c.CommandErrorHandler = nil
+	}()
+
+	var vo = &ControllerTestVO{Input: 12}
+	c.RegisterCommand("ControllerRecoverTest", func() interfaces.ICommand { return &ControllerTestPanicCommand{} })
+	c.RegisterAdditionalCommand("ControllerRecoverTest", func() interfaces.ICommand { return &ControllerTestCommand{} })
+	defer c.RemoveCommand("ControllerRecoverTest")
+
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	v.NotifyObservers(observer.NewNotification("ControllerRecoverTest", vo, ""))
+
+	// test assertions
+	if name != "ControllerRecoverTest" || recovered != vo {
+		t.Error("Expecting the handler to catch the panic of ControllerRecoverTest")
+	}
+	if vo.Result != 24 {
+		t.Error("Expecting the following command to execute, vo.Result == 24")
+	}
+}
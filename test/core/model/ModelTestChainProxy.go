@@ -0,0 +1,34 @@
+//
+//  ModelTestChainProxy.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package model
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/model"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/proxy"
+)
+
+const MODEL_TEST_CHAIN_PROXY = "modelTestChainProxy"
+const MODEL_TEST_CHAINED_PROXY = "modelTestChainedProxy"
+
+/*
+ModelTestChainProxy A Proxy that registers another Proxy
+when it is registered, and removes it when it is removed.
+*/
+type ModelTestChainProxy struct {
+	proxy.Proxy
+}
+
+func (self *ModelTestChainProxy) OnRegister() {
+	model.GetInstance(func() interfaces.IModel { return &model.Model{} }).RegisterProxy(&proxy.Proxy{Name: MODEL_TEST_CHAINED_PROXY})
+}
+
+func (self *ModelTestChainProxy) OnRemove() {
+	model.GetInstance(func() interfaces.IModel { return &model.Model{} }).RemoveProxy(MODEL_TEST_CHAINED_PROXY)
+}
@@ -0,0 +1,17 @@
+//
+//  ModelTestData.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package model
+
+/*
+ModelTestData A utility class used by ModelTest.
+*/
+type ModelTestData struct {
+	Title string
+	Count int
+}
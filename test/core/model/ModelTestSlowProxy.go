@@ -0,0 +1,26 @@
+//
+//  ModelTestSlowProxy.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package model
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/proxy"
+	"time"
+)
+
+/*
+ModelTestSlowProxy A Proxy whose OnRegister takes a while to initialize its data.
+*/
+type ModelTestSlowProxy struct {
+	proxy.Proxy
+}
+
+func (proxy *ModelTestSlowProxy) OnRegister() {
+	time.Sleep(time.Millisecond)
+	proxy.SetData(ON_REGISTER_CALLED)
+}
@@ -10,9 +10,17 @@ package model
 
 import (
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/model"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/proxy"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 /*
@@ -137,3 +145,333 @@ func TestOnRegisterAndOnRemove(t *testing.T) {
 		t.Error("Expecting p.GetData() == ON_REMOVE_CALLED")
 	}
 }
+
+/*
+Tests replacing a registered Proxy with another instance of the same name.
+*/
+func TestRegisterProxyReplace(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+
+	var oldProxy interfaces.IProxy = &ModelTestProxy{proxy.Proxy{Name: MODEL_TEST_PROXY}}
+	m.RegisterProxy(oldProxy)
+
+	var newProxy interfaces.IProxy = &ModelTestProxy{proxy.Proxy{Name: MODEL_TEST_PROXY}}
+	m.RegisterProxyReplace(newProxy)
+
+	// assert that the old proxy's onRemove and the new proxy's onRegister were called
+	if oldProxy.GetData() != ON_REMOVE_CALLED {
+		t.Error("Expecting oldProxy.GetData() == ON_REMOVE_CALLED")
+	}
+	if newProxy.GetData() != ON_REGISTER_CALLED {
+		t.Error("Expecting newProxy.GetData() == ON_REGISTER_CALLED")
+	}
+	if m.RetrieveProxy(MODEL_TEST_PROXY) != newProxy {
+		t.Error("Expecting m.RetrieveProxy(MODEL_TEST_PROXY) == newProxy")
+	}
+
+	m.RemoveProxy(MODEL_TEST_PROXY)
+}
+
+/*
+Tests observing the data changes of a registered Proxy.
+*/
+func TestObserveProxy(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+
+	var calls int
+	var oldData, newData interface{}
+	m.ObserveProxy("ModelObserveTest", func(old, new interface{}) {
+		calls++
+		oldData, newData = old, new
+	})
+
+	var p interfaces.IProxy = &proxy.Proxy{Name: "ModelObserveTest", Data: "old"}
+	m.RegisterProxy(p)
+	p.SetData("new")
+
+	// assert that the observer got the old and the new data
+	if calls != 1 || oldData != "old" || newData != "new" {
+		t.Error("Expecting calls == 1, oldData == 'old' and newData == 'new'")
+	}
+
+	// assert that changes are no longer observed once the proxy is removed
+	m.RemoveProxy("ModelObserveTest")
+	p.SetData("removed")
+	if calls != 1 {
+		t.Error("Expecting calls == 1")
+	}
+}
+
+/*
+Tests retrieving every registered Proxy at once.
+*/
+func TestRetrieveAllProxies(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+
+	var names = []string{"ModelAllTest1", "ModelAllTest2", "ModelAllTest3"}
+	for _, name := range names {
+		m.RegisterProxy(&proxy.Proxy{Name: name})
+	}
+
+	var found = map[string]bool{}
+	for _, p := range m.RetrieveAllProxies() {
+		found[p.GetProxyName()] = true
+	}
+
+	// test assertions
+	for _, name := range names {
+		if !found[name] {
+			t.Error("Expecting RetrieveAllProxies to contain ", name)
+		}
+		m.RemoveProxy(name)
+	}
+}
+
+/*
+Tests a Proxy that registers and removes another Proxy from its OnRegister and OnRemove.
+*/
+func TestRegisterProxyFromOnRegister(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+
+	var done = make(chan struct{})
+	go func() {
+		defer close(done)
+		m.RegisterProxy(&ModelTestChainProxy{proxy.Proxy{Name: MODEL_TEST_CHAIN_PROXY}})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expecting RegisterProxy not to deadlock")
+	}
+
+	// test assertions
+	if !m.HasProxy(MODEL_TEST_CHAIN_PROXY) || !m.HasProxy(MODEL_TEST_CHAINED_PROXY) {
+		t.Error("Expecting both proxies to be registered")
+	}
+
+	m.RemoveProxy(MODEL_TEST_CHAIN_PROXY)
+	if m.HasProxy(MODEL_TEST_CHAINED_PROXY) {
+		t.Error("Expecting the chained proxy to be removed")
+	}
+}
+
+/*
+Tests round-tripping the data of the registered Proxies through JSON.
+*/
+func TestExportAndImport(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+	var p = &proxy.Proxy{Name: "ModelExportTest", Data: ModelTestData{Title: "export", Count: 3}}
+	m.RegisterProxy(p)
+
+	var exported, err = m.Export()
+	if err != nil {
+		t.Fatal("Expecting err == nil, got ", err)
+	}
+
+	// clear the data, then restore it
+	p.SetData(ModelTestData{})
+	if err = m.Import(exported); err != nil {
+		t.Fatal("Expecting err == nil, got ", err)
+	}
+
+	// test assertions
+	if data, ok := p.GetData().(ModelTestData); !ok || data.Title != "export" || data.Count != 3 {
+		t.Error("Expecting p.GetData() == ModelTestData{Title: 'export', Count: 3}, got ", p.GetData())
+	}
+
+	m.RemoveProxy("ModelExportTest")
+}
+
+/*
+Tests that a Proxy with an empty name is not registered, and panics in debug mode.
+*/
+func TestRegisterEmptyNamedProxy(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	m.RegisterProxy(&proxy.Proxy{})
+	m.RegisterProxyReplace(&proxy.Proxy{})
+
+	// test assertions
+	if m.HasProxy("") {
+		t.Error("Expecting no proxy registered under an empty name")
+	}
+
+	debug.SetDebugMode(true)
+	defer debug.SetDebugMode(false)
+
+	defer func() {
+		var report = recover()
+		if message, ok := report.(string); !ok || !strings.Contains(message, "empty name") {
+			t.Error("Expecting a panic about the empty name, got ", report)
+		}
+	}()
+
+	m.RegisterProxy(&proxy.Proxy{})
+}
+
+/*
+Tests that concurrent RetrieveProxyOrRegister calls register a single Proxy.
+*/
+func TestStressRetrieveProxyOrRegister(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+	defer m.RemoveProxy("ModelOrRegisterTest")
+
+	var created atomic.Int32
+	var factory = func() interfaces.IProxy {
+		created.Add(1)
+		return &proxy.Proxy{Name: "ModelOrRegisterTest"}
+	}
+
+	var results = make([]interfaces.IProxy, 16)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = m.RetrieveProxyOrRegister("ModelOrRegisterTest", factory)
+		}(i)
+	}
+	wg.Wait()
+
+	// test assertions
+	if created.Load() != 1 {
+		t.Error("Expecting the factory to be called once, got ", created.Load())
+	}
+	for _, result := range results {
+		if result == nil || result != m.RetrieveProxy("ModelOrRegisterTest") {
+			t.Error("Expecting every caller to get the registered proxy")
+		}
+	}
+}
+
+/*
+Tests that a RetrieveProxyOrRegister factory returning nil or
+panicking leaves the Model usable.
+*/
+func TestRetrieveProxyOrRegisterFailingFactory(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	if m.RetrieveProxyOrRegister("ModelFailingFactoryTest", func() interfaces.IProxy { return nil }) != nil {
+		t.Error("Expecting nil for a factory returning nil")
+	}
+
+	func() {
+		defer func() { recover() }()
+		m.RetrieveProxyOrRegister("ModelFailingFactoryTest", func() interfaces.IProxy { panic("factory failed") })
+	}()
+
+	// test assertions
+	var registered = m.RetrieveProxyOrRegister("ModelFailingFactoryTest", func() interfaces.IProxy {
+		return &proxy.Proxy{Name: "ModelFailingFactoryTest"}
+	})
+	defer m.RemoveProxy("ModelFailingFactoryTest")
+	if registered == nil || !m.HasProxy("ModelFailingFactoryTest") {
+		t.Error("Expecting the Model to register the proxy after the failed factories")
+	}
+}
+
+/*
+Tests that Proxy retrievals are counted while access metrics are enabled.
+*/
+func TestProxyAccessCount(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+	m.RegisterProxy(&proxy.Proxy{Name: "ModelAccessCountTest"})
+	defer m.RemoveProxy("ModelAccessCountTest")
+
+	m.RetrieveProxy("ModelAccessCountTest")
+	if m.ProxyAccessCount("ModelAccessCountTest") != 0 {
+		t.Error("Expecting no count while access metrics are disabled")
+	}
+
+	m.EnableAccessMetrics(true)
+	defer m.EnableAccessMetrics(false)
+
+	m.RetrieveProxy("ModelAccessCountTest")
+	m.RetrieveProxy("ModelAccessCountTest")
+
+	// test assertions
+	if m.ProxyAccessCount("ModelAccessCountTest") != 2 {
+		t.Error("Expecting m.ProxyAccessCount('ModelAccessCountTest') == 2, got ", m.ProxyAccessCount("ModelAccessCountTest"))
+	}
+
+	// the Model's own lookups are not counted
+	m.UpdateProxyData("ModelAccessCountTest", func(old interface{}) interface{} { return 1 })
+	if m.ProxyAccessCount("ModelAccessCountTest") != 2 {
+		t.Error("Expecting UpdateProxyData to leave the count at 2, got ", m.ProxyAccessCount("ModelAccessCountTest"))
+	}
+}
+
+/*
+Tests that concurrent UpdateProxyData calls do not lose updates.
+*/
+func TestStressUpdateProxyData(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+	m.RegisterProxy(&proxy.Proxy{Name: "ModelUpdateDataTest", Data: 0})
+	defer m.RemoveProxy("ModelUpdateDataTest")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.UpdateProxyData("ModelUpdateDataTest", func(old interface{}) interface{} { return old.(int) + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+
+	// test assertions
+	m.UpdateProxyData("ModelUpdateDataTest", func(old interface{}) interface{} {
+		if old.(int) != 1600 {
+			t.Error("Expecting the counter == 1600, got ", old)
+		}
+		return old
+	})
+}
+
+/*
+Tests that a Proxy is never retrieved before its OnRegister completed,
+whether it is registered with RegisterProxy or RetrieveProxyOrRegister.
+*/
+func TestStressRegisterProxyBeforeVisible(t *testing.T) {
+	var m = model.GetInstance(func() interfaces.IModel { return &model.Model{} })
+
+	for i := 0; i < 20; i++ {
+		var uninitialized atomic.Int32
+		var wg sync.WaitGroup
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var retrieved interfaces.IProxy
+				for retrieved == nil {
+					retrieved = m.RetrieveProxy("ModelVisibleTest")
+				}
+				if retrieved.GetData() != ON_REGISTER_CALLED {
+					uninitialized.Add(1)
+				}
+			}()
+		}
+
+		var p = &ModelTestSlowProxy{proxy.Proxy{Name: "ModelVisibleTest"}}
+		if i%2 == 0 {
+			m.RegisterProxy(p)
+		} else {
+			m.RetrieveProxyOrRegister("ModelVisibleTest", func() interfaces.IProxy { return p })
+		}
+		wg.Wait()
+		m.RemoveProxy("ModelVisibleTest")
+
+		// test assertions
+		if uninitialized.Load() != 0 {
+			t.Fatal("Expecting the proxy to be initialized when retrieved")
+		}
+	}
+}
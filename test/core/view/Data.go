@@ -14,6 +14,7 @@ const VIEWTEST_NOTE3 = "Notification3"
 const VIEWTEST_NOTE4 = "Notification4"
 const VIEWTEST_NOTE5 = "Notification5"
 const VIEWTEST_NOTE6 = "Notification6"
+const VIEWTEST_NOTE7 = "Notification7"
 
 type Data struct {
 	lastNotification string
@@ -0,0 +1,24 @@
+//
+//  ViewTestCloneableVO.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+/*
+ViewTestCloneableVO A cloneable notification body used by ViewTest.
+*/
+type ViewTestCloneableVO struct {
+	Value int
+}
+
+/*
+Clone Copy the VO
+*/
+func (self *ViewTestCloneableVO) Clone() interface{} {
+	var clone = *self
+	return &clone
+}
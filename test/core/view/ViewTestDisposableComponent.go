@@ -0,0 +1,26 @@
+//
+//  ViewTestDisposableComponent.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+/*
+ViewTestDisposableComponent A disposable view component used by ViewTest.
+*/
+type ViewTestDisposableComponent struct {
+	callbacks []func()
+}
+
+func (component *ViewTestDisposableComponent) OnDispose(callback func()) {
+	component.callbacks = append(component.callbacks, callback)
+}
+
+func (component *ViewTestDisposableComponent) Dispose() {
+	for _, callback := range component.callbacks {
+		callback()
+	}
+}
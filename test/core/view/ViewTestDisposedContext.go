@@ -0,0 +1,27 @@
+//
+//  ViewTestDisposedContext.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "sync/atomic"
+
+/*
+ViewTestDisposedContext A notify context used by ViewTest
+that can be marked disposed.
+*/
+type ViewTestDisposedContext struct {
+	disposed atomic.Bool
+}
+
+func (context *ViewTestDisposedContext) Dispose() {
+	context.disposed.Store(true)
+}
+
+func (context *ViewTestDisposedContext) Disposed() bool {
+	return context.disposed.Load()
+}
@@ -0,0 +1,35 @@
+//
+//  ViewTestMediator7.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+)
+
+const ViewTestMediator7_NAME = "ViewTestMediator7"
+
+/*
+ViewTestMediator7 A Mediator class used by ViewTest.
+*/
+type ViewTestMediator7 struct {
+	mediator.Mediator
+}
+
+func (mediator *ViewTestMediator7) ListNotificationInterests() []string {
+	return []string{VIEWTEST_NOTE7}
+}
+
+func (mediator *ViewTestMediator7) HandleNotification(notification interfaces.INotification) {
+	mediator.ViewComponent.(*Data).counter++
+}
+
+func (mediator *ViewTestMediator7) OnRemove() {
+	mediator.ViewComponent.(*Data).onRemoveCalled = true
+}
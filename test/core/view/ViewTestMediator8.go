@@ -0,0 +1,38 @@
+//
+//  ViewTestMediator8.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+)
+
+const ViewTestMediator8_NAME = "ViewTestMediator8"
+const ViewTestMediator8_CHILD_NAME = "ViewTestMediator8Child"
+
+/*
+ViewTestMediator8 A Mediator class used by ViewTest.
+
+It registers a child Mediator when it is registered,
+and removes it when it is removed.
+*/
+type ViewTestMediator8 struct {
+	mediator.Mediator
+}
+
+func (mediator *ViewTestMediator8) OnRegister() {
+	var child = &ViewTestMediator{}
+	child.Name = ViewTestMediator8_CHILD_NAME
+	view.GetInstance(func() interfaces.IView { return &view.View{} }).RegisterMediator(child)
+}
+
+func (mediator *ViewTestMediator8) OnRemove() {
+	view.GetInstance(func() interfaces.IView { return &view.View{} }).RemoveMediator(ViewTestMediator8_CHILD_NAME)
+}
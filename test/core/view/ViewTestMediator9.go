@@ -0,0 +1,27 @@
+//
+//  ViewTestMediator9.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package view
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+
+const ViewTestMediator9_NAME = "ViewTestMediator9"
+
+/*
+ViewTestMediator9 A Mediator class used by ViewTest.
+
+Its interests can be changed after it is registered.
+*/
+type ViewTestMediator9 struct {
+	mediator.Mediator
+	Interests []string
+}
+
+func (mediator *ViewTestMediator9) ListNotificationInterests() []string {
+	return mediator.Interests
+}
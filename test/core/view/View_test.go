@@ -9,11 +9,22 @@
 package view
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 /*
@@ -33,6 +44,18 @@ func TestGetInstance(t *testing.T) {
 	}
 }
 
+/*
+Tests that the Default View is the Singleton
+*/
+func TestDefault(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	// test assertions
+	if view.Default() != v {
+		t.Error("Expecting view.Default() == v")
+	}
+}
+
 /*
 Tests registration and notification of Observers.
 
@@ -417,3 +440,1083 @@ func TestModifyObserverListDuringNotification(t *testing.T) {
 		t.Error("Expecting counter == 0")
 	}
 }
+
+/*
+Tests registering a Mediator that relies on the base
+ListNotificationInterests rather than overriding it.
+*/
+func TestRegisterMediatorWithDefaultInterests(t *testing.T) {
+	// Get the Singleton View instance
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	// ViewTestMediator4 only overrides the lifecycle hooks
+	var data = Data{}
+	var m = &ViewTestMediator4{mediator.Mediator{Name: ViewTestMediator4_NAME, ViewComponent: &data}}
+
+	if len(m.ListNotificationInterests()) != 0 {
+		t.Error("Expecting len(m.ListNotificationInterests()) == 0")
+	}
+
+	v.RegisterMediator(m)
+
+	// assert that the mediator registered cleanly
+	if v.RetrieveMediator(ViewTestMediator4_NAME) != m {
+		t.Error("Expecting v.RetrieveMediator(ViewTestMediator4_NAME) == m")
+	}
+	if data.onRegisterCalled != true {
+		t.Error("Expecting data.onRegisterCalled == true")
+	}
+
+	v.RemoveMediator(ViewTestMediator4_NAME)
+}
+
+/*
+Tests notifying Mediators that rely on the base HandleNotification
+rather than overriding it.
+*/
+func TestNotifyMediatorWithDefaultHandler(t *testing.T) {
+	// Get the Singleton View instance
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	// ViewTestMediator4 only overrides the lifecycle hooks,
+	// ViewTestMediator only overrides its interests
+	var data = Data{}
+	v.RegisterMediator(&ViewTestMediator4{mediator.Mediator{Name: ViewTestMediator4_NAME, ViewComponent: &data}})
+	v.RegisterMediator(&ViewTestMediator{Mediator: mediator.Mediator{Name: ViewTestMediator_NAME}})
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Error("Expecting no panic, got ", r)
+		}
+		v.RemoveMediator(ViewTestMediator4_NAME)
+		v.RemoveMediator(ViewTestMediator_NAME)
+	}()
+
+	// send a note the lifecycle-only mediator never asked for, and one the
+	// interests-only mediator will receive through the base handler
+	v.NotifyObservers(observer.NewNotification("ViewTestArbitraryNote", nil, ""))
+	v.NotifyObservers(observer.NewNotification("ABC", nil, ""))
+	v.RetrieveMediator(ViewTestMediator4_NAME).HandleNotification(observer.NewNotification("ABC", nil, ""))
+}
+
+/*
+Tests that an Observer registered for a Notification type
+ignores Notifications of the same name with a different type,
+while an Observer registered for the name receives both.
+*/
+func TestRegisterObserverForType(t *testing.T) {
+	// Get the Singleton View instance
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var typed, untyped []string
+	var typedContext, untypedContext = &Data{}, &Data{}
+	v.RegisterObserverForType("ViewTypeTestNote", "create", &observer.Observer{Notify: func(note interfaces.INotification) {
+		typed = append(typed, note.Type())
+	}, Context: typedContext})
+	v.RegisterObserver("ViewTypeTestNote", &observer.Observer{Notify: func(note interfaces.INotification) {
+		untyped = append(untyped, note.Type())
+	}, Context: untypedContext})
+
+	v.NotifyObservers(observer.NewNotification("ViewTypeTestNote", nil, "create"))
+	v.NotifyObservers(observer.NewNotification("ViewTypeTestNote", nil, "delete"))
+
+	// test assertions
+	if len(typed) != 1 || typed[0] != "create" {
+		t.Error("Expecting typed == [create]")
+	}
+	if len(untyped) != 2 {
+		t.Error("Expecting len(untyped) == 2")
+	}
+
+	// the type-scoped observer is removed by its context like any other
+	v.RemoveObserver("ViewTypeTestNote", typedContext)
+	v.RemoveObserver("ViewTypeTestNote", untypedContext)
+	v.NotifyObservers(observer.NewNotification("ViewTypeTestNote", nil, "create"))
+	if len(typed) != 1 {
+		t.Error("Expecting len(typed) == 1")
+	}
+	if len(untyped) != 2 {
+		t.Error("Expecting len(untyped) == 2")
+	}
+}
+
+/*
+Tests replacing a registered Mediator with another instance of the same name.
+*/
+func TestRegisterMediatorReplace(t *testing.T) {
+	// Get the Singleton View instance
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var oldData, newData = Data{}, Data{}
+	v.RegisterMediator(&ViewTestMediator7{mediator.Mediator{Name: ViewTestMediator7_NAME, ViewComponent: &oldData}})
+
+	var m = &ViewTestMediator7{mediator.Mediator{Name: ViewTestMediator7_NAME, ViewComponent: &newData}}
+	v.RegisterMediatorReplace(m)
+
+	// assert that the old mediator was removed and the new one retrievable
+	if oldData.onRemoveCalled != true {
+		t.Error("Expecting oldData.onRemoveCalled == true")
+	}
+	if v.RetrieveMediator(ViewTestMediator7_NAME) != m {
+		t.Error("Expecting v.RetrieveMediator(ViewTestMediator7_NAME) == m")
+	}
+
+	// only the new mediator receives notifications
+	v.NotifyObservers(observer.NewNotification(VIEWTEST_NOTE7, nil, ""))
+	if newData.counter != 1 {
+		t.Error("Expecting newData.counter == 1")
+	}
+	if oldData.counter != 0 {
+		t.Error("Expecting oldData.counter == 0")
+	}
+
+	v.RemoveMediator(ViewTestMediator7_NAME)
+}
+
+/*
+Tests removing one of two Observers that share a notify context.
+*/
+func TestRemoveObserverInstance(t *testing.T) {
+	// Get the Singleton View instance
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var data = Data{}
+	var first = &observer.Observer{Notify: func(note interfaces.INotification) { data.counter += 1 }, Context: &data}
+	var second = &observer.Observer{Notify: func(note interfaces.INotification) { data.counter += 10 }, Context: &data}
+	v.RegisterObserver("ViewInstanceTestNote", first)
+	v.RegisterObserver("ViewInstanceTestNote", second)
+
+	v.NotifyObservers(observer.NewNotification("ViewInstanceTestNote", nil, ""))
+	if data.counter != 11 {
+		t.Error("Expecting data.counter == 11")
+	}
+
+	// remove the first observer by instance, the second one keeps firing
+	v.RemoveObserverInstance("ViewInstanceTestNote", first)
+
+	data.counter = 0
+	v.NotifyObservers(observer.NewNotification("ViewInstanceTestNote", nil, ""))
+	if data.counter != 10 {
+		t.Error("Expecting data.counter == 10, got ", data.counter)
+	}
+
+	v.RemoveObserverInstance("ViewInstanceTestNote", second)
+
+	data.counter = 0
+	v.NotifyObservers(observer.NewNotification("ViewInstanceTestNote", nil, ""))
+	if data.counter != 0 {
+		t.Error("Expecting data.counter == 0")
+	}
+}
+
+/*
+Tests that the handle of an Observer removes that Observer, even if
+another Observer notifies a closure of the same function literal
+with the same notify context.
+*/
+func TestRemoveObserverInstanceIdentity(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var data = Data{}
+	var newObserver = func(increment int) interfaces.IObserver {
+		return &observer.Observer{Notify: func(note interfaces.INotification) { data.counter += increment }, Context: &data}
+	}
+	var first = newObserver(1)
+	var second = newObserver(10)
+	if first.Equals(second) {
+		t.Error("Expecting first.Equals(second) == false for closures of the same literal")
+	}
+	var removeFirst = v.RegisterObserverH("ViewIdentityTestNote", first)
+	var removeSecond = v.RegisterObserverH("ViewIdentityTestNote", second)
+	defer removeFirst()
+
+	// remove the second observer by its handle, the first one keeps firing
+	removeSecond()
+
+	v.NotifyObservers(observer.NewNotification("ViewIdentityTestNote", nil, ""))
+	if data.counter != 1 {
+		t.Error("Expecting data.counter == 1, got ", data.counter)
+	}
+}
+
+/*
+Tests retrieving every registered Mediator at once.
+*/
+func TestRetrieveAllMediators(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var m1 = &mediator.Mediator{Name: "ViewAllTest1"}
+	var m2 = &mediator.Mediator{Name: "ViewAllTest2"}
+	v.RegisterMediator(m1)
+	v.RegisterMediator(m2)
+
+	var found1, found2 bool
+	for _, m := range v.RetrieveAllMediators() {
+		found1 = found1 || m == m1
+		found2 = found2 || m == m2
+	}
+
+	// test assertions
+	if !found1 || !found2 {
+		t.Error("Expecting RetrieveAllMediators to contain both mediators")
+	}
+
+	v.RemoveMediator("ViewAllTest1")
+	v.RemoveMediator("ViewAllTest2")
+}
+
+/*
+Tests a Mediator that registers and removes a child Mediator from its OnRegister and OnRemove.
+*/
+func TestRegisterMediatorFromOnRegister(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var done = make(chan struct{})
+	go func() {
+		defer close(done)
+		v.RegisterMediator(&ViewTestMediator8{Mediator: mediator.Mediator{Name: ViewTestMediator8_NAME}})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expecting RegisterMediator not to deadlock")
+	}
+
+	// test assertions
+	if !v.HasMediator(ViewTestMediator8_NAME) || !v.HasMediator(ViewTestMediator8_CHILD_NAME) {
+		t.Error("Expecting both mediators to be registered")
+	}
+
+	v.RemoveMediator(ViewTestMediator8_NAME)
+	if v.HasMediator(ViewTestMediator8_CHILD_NAME) {
+		t.Error("Expecting the child mediator to be removed")
+	}
+}
+
+/*
+Stress tests notifying, registering and removing observers and mediators concurrently.
+
+Run with -race to detect unsynchronized access.
+*/
+func TestStressNotifyRegisterRemove(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	const goroutines = 48
+	const iterations = 200
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var name = fmt.Sprintf("ViewStressMediator%d", i)
+			var context = &ObserverTest{}
+			for j := 0; j < iterations; j++ {
+				switch i % 3 {
+				case 0:
+					v.RegisterObserver("ViewStressNote", &observer.Observer{Notify: func(interfaces.INotification) {}, Context: context})
+					v.RemoveObserver("ViewStressNote", context)
+				case 1:
+					v.RegisterMediator(&ViewTestMediator{Mediator: mediator.Mediator{Name: name}})
+					v.HasMediator(name)
+					v.RemoveMediator(name)
+				default:
+					v.NotifyObservers(observer.NewNotification("ViewStressNote", nil, ""))
+					v.NotifyObservers(observer.NewNotification("ABC", nil, ""))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// test assertions
+	if v.ObserverCounts()["ViewStressNote"] != 0 || v.ObserverCounts()["ABC"] != 0 {
+		t.Error("Expecting every stress observer to be removed")
+	}
+}
+
+/*
+Tests that removing an Observer reports whether it was registered.
+*/
+func TestRemoveObserverB(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var context = &ObserverTest{}
+	v.RegisterObserver("ViewRemoveBTest", &observer.Observer{Notify: NotifyTestMethod, Context: context})
+
+	// test assertions
+	if !v.RemoveObserverB("ViewRemoveBTest", context) {
+		t.Error("Expecting v.RemoveObserverB('ViewRemoveBTest', context) == true")
+	}
+	if v.RemoveObserverB("ViewRemoveBTest", context) {
+		t.Error("Expecting a second v.RemoveObserverB('ViewRemoveBTest', context) == false")
+	}
+	if v.RemoveObserverB("ViewRemoveBTest", &ObserverTest{}) {
+		t.Error("Expecting v.RemoveObserverB with an absent context == false")
+	}
+}
+
+/*
+Tests changing the priority of a registered Observer.
+*/
+func TestSetObserverPriority(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var order []string
+	var contexts = map[string]*ObserverTest{"a": {}, "b": {}, "c": {}}
+	for _, name := range []string{"a", "b", "c"} {
+		var name = name
+		v.RegisterObserver("ViewPriorityTest", &observer.Observer{Notify: func(interfaces.INotification) { order = append(order, name) }, Context: contexts[name]})
+	}
+
+	// bump the last observer to the top
+	v.SetObserverPriority("ViewPriorityTest", contexts["c"], 10)
+	v.NotifyObservers(observer.NewNotification("ViewPriorityTest", nil, ""))
+
+	// test assertions
+	if fmt.Sprint(order) != "[c a b]" {
+		t.Error("Expecting order == [c a b], got ", order)
+	}
+
+	// an observer registered afterwards has the default priority
+	contexts["d"] = &ObserverTest{}
+	v.RegisterObserver("ViewPriorityTest", &observer.Observer{Notify: func(interfaces.INotification) { order = append(order, "d") }, Context: contexts["d"]})
+	order = nil
+	v.NotifyObservers(observer.NewNotification("ViewPriorityTest", nil, ""))
+	if fmt.Sprint(order) != "[c a b d]" {
+		t.Error("Expecting order == [c a b d], got ", order)
+	}
+
+	for _, context := range contexts {
+		v.RemoveObserver("ViewPriorityTest", context)
+	}
+}
+
+/*
+Tests that a filtered Observer ignores Notifications failing its predicate.
+*/
+func TestRegisterObserverFiltered(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var hot, all []int
+	var hotContext, allContext = &Data{}, &Data{}
+	v.RegisterObserverFiltered("ViewFilterTestNote", &observer.Observer{Notify: func(note interfaces.INotification) {
+		hot = append(hot, note.Body().(int))
+	}, Context: hotContext}, func(note interfaces.INotification) bool {
+		return note.Body().(int) > 30
+	})
+	v.RegisterObserver("ViewFilterTestNote", &observer.Observer{Notify: func(note interfaces.INotification) {
+		all = append(all, note.Body().(int))
+	}, Context: allContext})
+
+	for _, reading := range []int{20, 35, 25, 40} {
+		v.NotifyObservers(observer.NewNotification("ViewFilterTestNote", reading, ""))
+	}
+
+	// test assertions
+	if fmt.Sprint(hot) != "[35 40]" {
+		t.Error("Expecting hot == [35 40], got ", hot)
+	}
+	if len(all) != 4 {
+		t.Error("Expecting len(all) == 4")
+	}
+
+	v.RemoveObserver("ViewFilterTestNote", hotContext)
+	v.RemoveObserver("ViewFilterTestNote", allContext)
+}
+
+/*
+Tests that a Mediator is removed when its view component is disposed.
+*/
+func TestDisposeViewComponent(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var component = &ViewTestDisposableComponent{}
+	v.RegisterMediator(&mediator.Mediator{Name: "ViewDisposeTest", ViewComponent: component})
+
+	if !v.HasMediator("ViewDisposeTest") {
+		t.Error("Expecting v.HasMediator('ViewDisposeTest') == true")
+	}
+
+	component.Dispose()
+
+	// test assertions
+	if v.HasMediator("ViewDisposeTest") {
+		t.Error("Expecting v.HasMediator('ViewDisposeTest') == false after dispose")
+	}
+}
+
+/*
+Tests that registering a Mediator with an empty name panics in debug mode.
+*/
+func TestRegisterEmptyNamedMediatorInDebugMode(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	debug.SetDebugMode(true)
+	defer debug.SetDebugMode(false)
+
+	defer func() {
+		// test assertions
+		var report = recover()
+		if message, ok := report.(string); !ok || !strings.Contains(message, "empty name") {
+			t.Error("Expecting a panic about the empty name, got ", report)
+		}
+		if v.HasMediator("") {
+			t.Error("Expecting no mediator registered under an empty name")
+		}
+	}()
+
+	v.RegisterMediator(&mediator.Mediator{})
+}
+
+/*
+Tests that a Mediator with an empty name is not registered.
+*/
+func TestRegisterEmptyNamedMediator(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	v.RegisterMediator(&mediator.Mediator{})
+
+	// test assertions
+	if v.HasMediator("") {
+		t.Error("Expecting no mediator registered under an empty name")
+	}
+}
+
+/*
+Tests that a batch of Notifications is dispatched in order, taking
+into account Observers removed while handling an earlier one.
+*/
+func TestNotifyObserversBatch(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var order []string
+	var context = &ObserverTest{}
+	var record = func(notification interfaces.INotification) {
+		order = append(order, notification.Name())
+		if notification.Name() == "ViewBatchTest2" {
+			v.RemoveObserver("ViewBatchTest3", context)
+		}
+	}
+	for _, name := range []string{"ViewBatchTest1", "ViewBatchTest2", "ViewBatchTest3"} {
+		v.RegisterObserver(name, &observer.Observer{Notify: record, Context: context})
+	}
+
+	v.NotifyObserversBatch([]interfaces.INotification{
+		observer.NewNotification("ViewBatchTest1", nil, ""),
+		observer.NewNotification("ViewBatchTest2", nil, ""),
+		observer.NewNotification("ViewBatchTest1", nil, ""),
+		observer.NewNotification("ViewBatchTest3", nil, ""),
+	})
+
+	// test assertions
+	if fmt.Sprint(order) != "[ViewBatchTest1 ViewBatchTest2 ViewBatchTest1]" {
+		t.Error("Expecting order == [ViewBatchTest1 ViewBatchTest2 ViewBatchTest1], got ", order)
+	}
+
+	v.RemoveObserver("ViewBatchTest1", context)
+	v.RemoveObserver("ViewBatchTest2", context)
+}
+
+/*
+Tests that the contexts of the Observers for a Notification are listed.
+*/
+func TestObserverContexts(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var data = Data{}
+	var first = &ViewTestMediator2{Mediator: mediator.Mediator{Name: "ViewContextsTest1", ViewComponent: &data}}
+	var second = &ViewTestMediator2{Mediator: mediator.Mediator{Name: "ViewContextsTest2", ViewComponent: &data}}
+	v.RegisterMediator(first)
+	v.RegisterMediator(second)
+
+	var contexts = v.ObserverContexts(VIEWTEST_NOTE1)
+
+	// test assertions
+	var found = map[interface{}]bool{}
+	for _, context := range contexts {
+		found[context] = true
+	}
+	if !found[first] || !found[second] {
+		t.Error("Expecting both mediators among the contexts for VIEWTEST_NOTE1")
+	}
+
+	v.RemoveMediator("ViewContextsTest1")
+	v.RemoveMediator("ViewContextsTest2")
+
+	if len(v.ObserverContexts(VIEWTEST_NOTE1)) != len(contexts)-2 {
+		t.Error("Expecting the mediators' contexts to be gone after removal")
+	}
+}
+
+/*
+Tests that concurrent RetrieveMediatorOrRegister calls register a single Mediator.
+*/
+func TestStressRetrieveMediatorOrRegister(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	defer v.RemoveMediator("ViewOrRegisterTest")
+
+	var created atomic.Int32
+	var factory = func() interfaces.IMediator {
+		created.Add(1)
+		return &ViewTestMediator{Mediator: mediator.Mediator{Name: "ViewOrRegisterTest"}}
+	}
+
+	var results = make([]interfaces.IMediator, 16)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = v.RetrieveMediatorOrRegister("ViewOrRegisterTest", factory)
+		}(i)
+	}
+	wg.Wait()
+
+	// test assertions
+	if created.Load() != 1 {
+		t.Error("Expecting the factory to be called once, got ", created.Load())
+	}
+	for _, result := range results {
+		if result == nil || result != v.RetrieveMediator("ViewOrRegisterTest") {
+			t.Error("Expecting every caller to get the registered mediator")
+		}
+	}
+	if v.ObserverCounts()["ABC"] < 1 {
+		t.Error("Expecting the mediator's observers to be registered")
+	}
+}
+
+/*
+Tests that a RetrieveMediatorOrRegister factory returning nil or
+panicking leaves the View usable.
+*/
+func TestRetrieveMediatorOrRegisterFailingFactory(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	if v.RetrieveMediatorOrRegister("ViewFailingFactoryTest", func() interfaces.IMediator { return nil }) != nil {
+		t.Error("Expecting nil for a factory returning nil")
+	}
+
+	func() {
+		defer func() { recover() }()
+		v.RetrieveMediatorOrRegister("ViewFailingFactoryTest", func() interfaces.IMediator { panic("factory failed") })
+	}()
+
+	// test assertions
+	var registered = v.RetrieveMediatorOrRegister("ViewFailingFactoryTest", func() interfaces.IMediator {
+		return &mediator.Mediator{Name: "ViewFailingFactoryTest"}
+	})
+	defer v.RemoveMediator("ViewFailingFactoryTest")
+	if registered == nil || !v.HasMediator("ViewFailingFactoryTest") {
+		t.Error("Expecting the View to register the mediator after the failed factories")
+	}
+}
+
+/*
+Tests that the handle returned by RegisterObserverH removes the Observer.
+*/
+func TestRegisterObserverH(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var count = 0
+	var remove = v.RegisterObserverH("ViewHandleTest", &observer.Observer{Notify: func(interfaces.INotification) { count++ }, Context: &ObserverTest{}})
+
+	v.NotifyObservers(observer.NewNotification("ViewHandleTest", nil, ""))
+	if count != 1 {
+		t.Error("Expecting count == 1")
+	}
+
+	remove()
+	remove()
+	v.NotifyObservers(observer.NewNotification("ViewHandleTest", nil, ""))
+
+	// test assertions
+	if count != 1 {
+		t.Error("Expecting count == 1 after removal")
+	}
+	if v.ObserverCounts()["ViewHandleTest"] != 0 {
+		t.Error("Expecting no observers left for ViewHandleTest")
+	}
+}
+
+/*
+Tests that each Observer gets its own copy of a cloneable body.
+*/
+func TestNotifyObserversIsolated(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var first, second = &ObserverTest{}, &ObserverTest{}
+	var seen = -1
+	v.RegisterObserver("ViewIsolatedTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		notification.Body().(*ViewTestCloneableVO).Value = 99
+	}, Context: first})
+	v.RegisterObserver("ViewIsolatedTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		seen = notification.Body().(*ViewTestCloneableVO).Value
+	}, Context: second})
+	defer v.RemoveObserver("ViewIsolatedTest", first)
+	defer v.RemoveObserver("ViewIsolatedTest", second)
+
+	var vo = &ViewTestCloneableVO{Value: 1}
+	v.NotifyObserversIsolated(observer.NewNotification("ViewIsolatedTest", vo, ""))
+
+	// test assertions
+	if seen != 1 {
+		t.Error("Expecting the second observer to see the original value 1, got ", seen)
+	}
+	if vo.Value != 1 {
+		t.Error("Expecting the original body to be unchanged")
+	}
+
+	// without isolation, the mutation is seen by the second observer
+	v.NotifyObservers(observer.NewNotification("ViewIsolatedTest", vo, ""))
+	if seen != 99 {
+		t.Error("Expecting the second observer to see the mutated value 99, got ", seen)
+	}
+}
+
+/*
+Tests that a group notification only reaches the Mediators of that group.
+*/
+func TestNotifyGroup(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var settings, toolbar = Data{}, Data{}
+	v.RegisterMediatorInGroup(&ViewTestMediator2{Mediator: mediator.Mediator{Name: "ViewGroupTest1", ViewComponent: &settings}}, "settings")
+	v.RegisterMediatorInGroup(&ViewTestMediator2{Mediator: mediator.Mediator{Name: "ViewGroupTest2", ViewComponent: &toolbar}}, "toolbar")
+	defer v.RemoveMediator("ViewGroupTest1")
+	defer v.RemoveMediator("ViewGroupTest2")
+
+	v.NotifyGroup("settings", observer.NewNotification(VIEWTEST_NOTE1, nil, ""))
+
+	// test assertions
+	if settings.lastNotification != VIEWTEST_NOTE1 {
+		t.Error("Expecting the settings mediator to be notified")
+	}
+	if toolbar.lastNotification != "" {
+		t.Error("Expecting the toolbar mediator not to be notified")
+	}
+
+	v.NotifyGroup("toolbar", observer.NewNotification(VIEWTEST_NOTE2, nil, ""))
+	if settings.lastNotification != VIEWTEST_NOTE1 || toolbar.lastNotification != VIEWTEST_NOTE2 {
+		t.Error("Expecting only the toolbar mediator to be notified of VIEWTEST_NOTE2")
+	}
+}
+
+/*
+Tests that a global Observer receives every Notification, after the named Observers.
+*/
+func TestRegisterGlobalObserver(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var received []string
+	var global, named = &ObserverTest{}, &ObserverTest{}
+	v.RegisterGlobalObserver(&observer.Observer{Notify: func(notification interfaces.INotification) {
+		received = append(received, notification.Name())
+	}, Context: global})
+	v.RegisterObserver("ViewGlobalTest1", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		received = append(received, "named")
+	}, Context: named})
+
+	v.NotifyObservers(observer.NewNotification("ViewGlobalTest1", nil, ""))
+	v.NotifyObservers(observer.NewNotification("ViewGlobalTest2", nil, ""))
+	v.NotifyObservers(observer.NewNotification("ViewGlobalTest3", nil, ""))
+
+	v.RemoveGlobalObserver(global)
+	v.RemoveObserver("ViewGlobalTest1", named)
+	v.NotifyObservers(observer.NewNotification("ViewGlobalTest4", nil, ""))
+
+	// test assertions
+	if fmt.Sprint(received) != "[named ViewGlobalTest1 ViewGlobalTest2 ViewGlobalTest3]" {
+		t.Error("Expecting received == [named ViewGlobalTest1 ViewGlobalTest2 ViewGlobalTest3], got ", received)
+	}
+}
+
+/*
+Tests that an Observer registered with a context is removed once the context is cancelled.
+*/
+func TestRegisterObserverCtx(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var count atomic.Int32
+	var ctx, cancel = context.WithCancel(context.Background())
+	v.RegisterObserverCtx(ctx, "ViewCtxTest", &observer.Observer{Notify: func(interfaces.INotification) { count.Add(1) }, Context: &ObserverTest{}})
+
+	v.NotifyObservers(observer.NewNotification("ViewCtxTest", nil, ""))
+	if count.Load() != 1 {
+		t.Error("Expecting count == 1")
+	}
+
+	cancel()
+	var deadline = time.Now().Add(time.Second)
+	for v.ObserverCounts()["ViewCtxTest"] != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	v.NotifyObservers(observer.NewNotification("ViewCtxTest", nil, ""))
+
+	// test assertions
+	if count.Load() != 1 {
+		t.Error("Expecting count == 1 after the context is cancelled")
+	}
+}
+
+/*
+Tests that registering twice under a key replaces the Observer,
+and that removing by key removes it.
+*/
+func TestRegisterObserverKeyed(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var count = 0
+	var notify = func(interfaces.INotification) { count++ }
+	v.RegisterObserverKeyed("ViewKeyedTest", "audit", &observer.Observer{Notify: notify, Context: &ObserverTest{}})
+	v.RegisterObserverKeyed("ViewKeyedTest", "audit", &observer.Observer{Notify: notify, Context: &ObserverTest{}})
+
+	v.NotifyObservers(observer.NewNotification("ViewKeyedTest", nil, ""))
+
+	// test assertions
+	if count != 1 {
+		t.Error("Expecting a single delivery, got ", count)
+	}
+
+	if !v.RemoveObserverKeyed("ViewKeyedTest", "audit") {
+		t.Error("Expecting v.RemoveObserverKeyed('ViewKeyedTest', 'audit') == true")
+	}
+	v.NotifyObservers(observer.NewNotification("ViewKeyedTest", nil, ""))
+	if count != 1 {
+		t.Error("Expecting no delivery after removal, got ", count)
+	}
+	if v.RemoveObserverKeyed("ViewKeyedTest", "audit") {
+		t.Error("Expecting a second v.RemoveObserverKeyed('ViewKeyedTest', 'audit') == false")
+	}
+}
+
+/*
+Tests listing the Notification names a Mediator observes.
+*/
+func TestMediatorInterests(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var data = Data{}
+	v.RegisterMediator(&ViewTestMediator2{Mediator: mediator.Mediator{Name: "ViewInterestsTest", ViewComponent: &data}})
+	defer v.RemoveMediator("ViewInterestsTest")
+
+	var interests = v.MediatorInterests("ViewInterestsTest")
+
+	// test assertions
+	if fmt.Sprint(interests) != fmt.Sprint([]string{VIEWTEST_NOTE1, VIEWTEST_NOTE2}) {
+		t.Error("Expecting interests == [VIEWTEST_NOTE1 VIEWTEST_NOTE2], got ", interests)
+	}
+	if v.MediatorInterests("ViewInterestsMissing") != nil {
+		t.Error("Expecting no interests for an unregistered mediator")
+	}
+}
+
+/*
+Tests that mediator names are listed in the order the mediators were registered.
+*/
+func TestMediatorRegistrationOrder(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var registered = []string{"ViewOrderTestC", "ViewOrderTestA", "ViewOrderTestB"}
+	for _, name := range registered {
+		v.RegisterMediator(&mediator.Mediator{Name: name})
+		defer v.RemoveMediator(name)
+	}
+
+	var order []string
+	for _, name := range v.MediatorRegistrationOrder() {
+		if strings.HasPrefix(name, "ViewOrderTest") {
+			order = append(order, name)
+		}
+	}
+
+	// test assertions
+	if fmt.Sprint(order) != fmt.Sprint(registered) {
+		t.Error("Expecting order == [ViewOrderTestC ViewOrderTestA ViewOrderTestB], got ", order)
+	}
+}
+
+/*
+Tests that removing a mediator whose interests changed after
+registration removes the observers it was registered with.
+*/
+func TestRemoveMediatorWithChangedInterests(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var m = &ViewTestMediator9{Mediator: mediator.Mediator{Name: ViewTestMediator9_NAME}, Interests: []string{"ViewChangedNote1", "ViewChangedNote2"}}
+	v.RegisterMediator(m)
+
+	m.Interests = []string{"ViewChangedNote3"}
+	v.RemoveMediator(ViewTestMediator9_NAME)
+
+	// test assertions
+	var counts = v.ObserverCounts()
+	if counts["ViewChangedNote1"] != 0 || counts["ViewChangedNote2"] != 0 {
+		t.Error("Expecting the observers of the original interests to be removed")
+	}
+	if v.HasMediator(ViewTestMediator9_NAME) {
+		t.Error("Expecting the mediator to be removed")
+	}
+}
+
+/*
+Tests that no observers leak when a mediator mutates, in place,
+the interest slice it was registered with.
+*/
+func TestRemoveMediatorWithMutatedInterests(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var m = &ViewTestMediator9{Mediator: mediator.Mediator{Name: ViewTestMediator9_NAME}, Interests: []string{"ViewMutatedNote1", "ViewMutatedNote2"}}
+	v.RegisterMediator(m)
+
+	m.Interests[0] = "ViewMutatedNote3"
+	v.RemoveMediator(ViewTestMediator9_NAME)
+
+	// test assertions
+	var counts = v.ObserverCounts()
+	if counts["ViewMutatedNote1"] != 0 || counts["ViewMutatedNote2"] != 0 {
+		t.Error("Expecting the observers of the registered interests to be removed")
+	}
+}
+
+/*
+Tests that NotifyObserversNoCopy notifies a static observer list,
+then the prefix observers, in the same order as NotifyObservers.
+*/
+func TestNotifyObserversNoCopy(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var order []string
+	var contexts = []*ObserverTest{{}, {}, {}}
+	for i, context := range contexts {
+		var label = fmt.Sprint(i + 1)
+		v.RegisterObserver("ViewNoCopyTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+			order = append(order, label)
+		}, Context: context})
+		defer v.RemoveObserver("ViewNoCopyTest", context)
+	}
+	var prefixContext = &ObserverTest{}
+	v.RegisterPrefixObserver("ViewNoCopy", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		order = append(order, "prefix")
+	}, Context: prefixContext})
+	defer v.RemovePrefixObserver("ViewNoCopy", prefixContext)
+
+	v.NotifyObserversNoCopy(observer.NewNotification("ViewNoCopyTest", nil, ""))
+
+	// test assertions
+	if fmt.Sprint(order) != "[1 2 3 prefix]" {
+		t.Error("Expecting order == [1 2 3 prefix], got ", order)
+	}
+}
+
+/*
+benchmarkNotify Measure a notify func against a static list of observers.
+*/
+func benchmarkNotify(b *testing.B, name string, notify func(v interfaces.IView, notification interfaces.INotification)) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	for i := 0; i < 8; i++ {
+		var context = &ObserverTest{}
+		v.RegisterObserver(name, &observer.Observer{Notify: func(notification interfaces.INotification) {}, Context: context})
+		defer v.RemoveObserver(name, context)
+	}
+	var notification = observer.NewNotification(name, nil, "")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		notify(v, notification)
+	}
+}
+
+/*
+Benchmarks NotifyObservers, which copies the observer list to a pooled working array.
+*/
+func BenchmarkNotifyObservers(b *testing.B) {
+	benchmarkNotify(b, "ViewBenchCopy", interfaces.IView.NotifyObservers)
+}
+
+/*
+Benchmarks NotifyObserversNoCopy, which does not copy the observer list.
+*/
+func BenchmarkNotifyObserversNoCopy(b *testing.B) {
+	benchmarkNotify(b, "ViewBenchNoCopy", interfaces.IView.NotifyObserversNoCopy)
+}
+
+/*
+Tests that concurrent and nested notifications, each drawing
+a working array from the pool, notify every observer exactly once.
+*/
+func TestStressNotifyObserversPooled(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var outer, inner atomic.Int32
+	var innerContext = &ObserverTest{}
+	v.RegisterObserver("ViewPoolInnerTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		inner.Add(1)
+	}, Context: innerContext})
+	defer v.RemoveObserver("ViewPoolInnerTest", innerContext)
+
+	for i := 0; i < 3; i++ {
+		var context = &ObserverTest{}
+		v.RegisterObserver("ViewPoolTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+			outer.Add(1)
+			v.NotifyObservers(observer.NewNotification("ViewPoolInnerTest", nil, ""))
+		}, Context: context})
+		defer v.RemoveObserver("ViewPoolTest", context)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				v.NotifyObservers(observer.NewNotification("ViewPoolTest", nil, ""))
+			}
+		}()
+	}
+	wg.Wait()
+
+	// test assertions
+	if outer.Load() != 8*500*3 || inner.Load() != 8*500*3 {
+		t.Error("Expecting every observer to be notified once per notification, got ", outer.Load(), " and ", inner.Load())
+	}
+}
+
+/*
+Tests that a set of mediators with a colliding name is not
+registered at all, and that the error names the collision.
+*/
+func TestRegisterMediatorsStrict(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	v.RegisterMediator(&mediator.Mediator{Name: "ViewStrictTestTaken"})
+	defer v.RemoveMediator("ViewStrictTestTaken")
+
+	var err = v.RegisterMediatorsStrict([]interfaces.IMediator{
+		&mediator.Mediator{Name: "ViewStrictTestA"},
+		&mediator.Mediator{Name: "ViewStrictTestTaken"},
+		&mediator.Mediator{Name: "ViewStrictTestB"},
+	})
+
+	// test assertions
+	if !errors.Is(err, view.ErrMediatorExists) || !strings.Contains(err.Error(), "ViewStrictTestTaken") {
+		t.Error("Expecting an ErrMediatorExists naming ViewStrictTestTaken, got ", err)
+	}
+	if v.HasMediator("ViewStrictTestA") || v.HasMediator("ViewStrictTestB") {
+		t.Error("Expecting no mediator of the set to be registered")
+	}
+
+	err = v.RegisterMediatorsStrict([]interfaces.IMediator{
+		&mediator.Mediator{Name: "ViewStrictTestA"},
+		&mediator.Mediator{Name: "ViewStrictTestB"},
+	})
+	defer v.RemoveMediator("ViewStrictTestA")
+	defer v.RemoveMediator("ViewStrictTestB")
+
+	if err != nil || !v.HasMediator("ViewStrictTestA") || !v.HasMediator("ViewStrictTestB") {
+		t.Error("Expecting every mediator of the set to be registered, got ", err)
+	}
+}
+
+/*
+Tests that a weakly registered observer is no longer notified
+once its context is disposed, and is removed by a sweep.
+*/
+func TestRegisterObserverWeak(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var calls = 0
+	var disposable = &ViewTestDisposedContext{}
+	v.RegisterObserverWeak("ViewWeakTest", &observer.Observer{Notify: func(notification interfaces.INotification) { calls++ }, Context: disposable})
+	defer v.RemoveObserver("ViewWeakTest", disposable)
+
+	v.NotifyObservers(observer.NewNotification("ViewWeakTest", nil, ""))
+	if calls != 1 {
+		t.Error("Expecting calls == 1 before the context is disposed")
+	}
+
+	disposable.Dispose()
+	v.NotifyObservers(observer.NewNotification("ViewWeakTest", nil, ""))
+
+	// test assertions
+	if calls != 1 {
+		t.Error("Expecting calls == 1 after the context is disposed")
+	}
+	if swept := v.SweepObservers(); swept != 1 {
+		t.Error("Expecting the sweep to remove 1 observer, got ", swept)
+	}
+	if v.ObserverCounts()["ViewWeakTest"] != 0 {
+		t.Error("Expecting no observer for ViewWeakTest after the sweep")
+	}
+}
+
+/*
+Tests that dispatches are counted per notification name while
+dispatch metrics are enabled, and discarded once disabled.
+*/
+func TestDispatchMetrics(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	v.EnableDispatchMetrics(true)
+	defer v.EnableDispatchMetrics(false)
+
+	v.NotifyObservers(observer.NewNotification("ViewMetricsTest", nil, ""))
+	v.NotifyObservers(observer.NewNotification("ViewMetricsTest", nil, ""))
+
+	// test assertions
+	var metric = v.DispatchMetrics()["ViewMetricsTest"]
+	if metric.Count != 2 || metric.Total < 0 {
+		t.Error("Expecting ViewMetricsTest to be dispatched twice, got ", metric.Count)
+	}
+
+	v.EnableDispatchMetrics(false)
+	if len(v.DispatchMetrics()) != 0 {
+		t.Error("Expecting the metrics to be discarded once disabled")
+	}
+}
+
+/*
+Tests that a retained observer keeps being notified until
+it is released as many times as it was retained.
+*/
+func TestRetainObserver(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var calls = 0
+	var context = &ObserverTest{}
+	var notify = func(notification interfaces.INotification) { calls++ }
+	v.RetainObserver("ViewRetainTest", &observer.Observer{Notify: notify, Context: context})
+	v.RetainObserver("ViewRetainTest", &observer.Observer{Notify: notify, Context: context})
+	defer v.RemoveObserver("ViewRetainTest", context)
+
+	v.NotifyObservers(observer.NewNotification("ViewRetainTest", nil, ""))
+
+	// test assertions
+	if calls != 1 {
+		t.Error("Expecting the retained observer to be registered once, got ", calls)
+	}
+
+	v.ReleaseObserver("ViewRetainTest", context)
+	v.NotifyObservers(observer.NewNotification("ViewRetainTest", nil, ""))
+	if calls != 2 {
+		t.Error("Expecting the observer to be notified while still retained, got ", calls)
+	}
+
+	v.ReleaseObserver("ViewRetainTest", context)
+	v.NotifyObservers(observer.NewNotification("ViewRetainTest", nil, ""))
+	if calls != 2 {
+		t.Error("Expecting the observer to be removed once released, got ", calls)
+	}
+}
@@ -0,0 +1,37 @@
+//
+//  DelayedCommandTestCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+	"time"
+)
+
+/*
+DelayedCommandTestCommand A DelayedCommand subclass used by DelayedCommandTest.
+*/
+type DelayedCommandTestCommand struct {
+	command.DelayedCommand
+}
+
+/*
+Execute Count the run, re-running once after a short delay
+
+- parameter note: the Notification carrying the DelayedCommandTestVO
+*/
+func (self *DelayedCommandTestCommand) Execute(notification interfaces.INotification) {
+	var vo = notification.Body().(*DelayedCommandTestVO)
+
+	if vo.Runs.Add(1) == 1 {
+		self.ScheduleNotification(notification.Name(), vo, notification.Type(), 10*time.Millisecond)
+	} else {
+		close(vo.Done)
+	}
+}
@@ -0,0 +1,19 @@
+//
+//  DelayedCommandTestVO.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import "sync/atomic"
+
+/*
+DelayedCommandTestVO A utility class used by DelayedCommandTest.
+*/
+type DelayedCommandTestVO struct {
+	Runs atomic.Int32
+	Done chan struct{}
+}
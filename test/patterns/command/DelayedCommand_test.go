@@ -0,0 +1,47 @@
+//
+//  DelayedCommand_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"testing"
+	"time"
+)
+
+/*
+Test the PureMVC DelayedCommand class.
+*/
+
+/*
+Tests that a DelayedCommand re-runs after scheduling its own notification.
+*/
+func TestDelayedCommandReRuns(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("DelayedCommandTestNote", func() interfaces.ICommand { return &DelayedCommandTestCommand{} })
+	defer f.RemoveCommand("DelayedCommandTestNote")
+
+	var vo = DelayedCommandTestVO{Done: make(chan struct{})}
+	f.SendNotification("DelayedCommandTestNote", &vo, "")
+
+	if vo.Runs.Load() != 1 {
+		t.Error("Expecting vo.Runs == 1 before the delay")
+	}
+
+	select {
+	case <-vo.Done:
+	case <-time.After(time.Second):
+		t.Fatal("Expecting the command to re-run after the delay")
+	}
+
+	// test assertions
+	if vo.Runs.Load() != 2 {
+		t.Error("Expecting vo.Runs == 2")
+	}
+}
@@ -0,0 +1,43 @@
+//
+//  MacroCommandTestPreparedCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+MacroCommandTestPreparedCommand A MacroCommand subclass used by MacroCommandTest
+that relies on the base Execute and PrepareSubCommands.
+*/
+type MacroCommandTestPreparedCommand struct {
+	command.MacroCommand
+	initialized int // the number of calls to InitializeMacroCommand
+}
+
+/*
+NewMacroCommandTestPreparedCommand Create a MacroCommandTestPreparedCommand
+whose Initializer is its own InitializeMacroCommand.
+*/
+func NewMacroCommandTestPreparedCommand() *MacroCommandTestPreparedCommand {
+	var c = &MacroCommandTestPreparedCommand{}
+	c.Initializer = c.InitializeMacroCommand
+	return c
+}
+
+/*
+InitializeMacroCommand Initialize the MacroCommandTestPreparedCommand by adding
+its 2 SubCommands.
+*/
+func (self *MacroCommandTestPreparedCommand) InitializeMacroCommand() {
+	self.initialized++
+	self.AddSubCommand(func() interfaces.ICommand { return &MacroCommandTestSub1Command{} })
+	self.AddSubCommand(func() interfaces.ICommand { return &MacroCommandTestSub2Command{} })
+}
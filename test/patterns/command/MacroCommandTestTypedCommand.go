@@ -0,0 +1,43 @@
+//
+//  MacroCommandTestTypedCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+MacroCommandTestTypedCommand A MacroCommand subclass used by MacroCommandTest
+whose SubCommands depend on the type of the notification.
+*/
+type MacroCommandTestTypedCommand struct {
+	command.MacroCommand
+}
+
+/*
+NewMacroCommandTestTypedCommand Create a MacroCommandTestTypedCommand
+whose InitializerWith is its own addSubCommandsFor.
+*/
+func NewMacroCommandTestTypedCommand() *MacroCommandTestTypedCommand {
+	var c = &MacroCommandTestTypedCommand{}
+	c.InitializerWith = c.addSubCommandsFor
+	return c
+}
+
+/*
+addSubCommandsFor Add MacroCommandTestSub1Command, and
+MacroCommandTestSub2Command too if the notification type is "both".
+*/
+func (self *MacroCommandTestTypedCommand) addSubCommandsFor(notification interfaces.INotification) {
+	self.AddSubCommand(func() interfaces.ICommand { return &MacroCommandTestSub1Command{} })
+	if notification.Type() == "both" {
+		self.AddSubCommand(func() interfaces.ICommand { return &MacroCommandTestSub2Command{} })
+	}
+}
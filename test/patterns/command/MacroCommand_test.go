@@ -87,3 +87,61 @@ func TestMacroCommandExecuteViaControllerView(t *testing.T) {
 		t.Error("Expecting vo.Result2 == 25")
 	}
 }
+
+/*
+Tests inspecting a MacroCommand's SubCommands before it executes,
+with the base PrepareSubCommands and Execute.
+*/
+func TestMacroCommandPrepareSubCommands(t *testing.T) {
+	var c = NewMacroCommandTestPreparedCommand()
+	c.Notifier.InitializeNotifier()
+
+	if c.SubCommandCount() != 0 {
+		t.Error("Expecting c.SubCommandCount() == 0")
+	}
+
+	// Initialize the SubCommands without executing them
+	c.PrepareSubCommands()
+	c.PrepareSubCommands()
+
+	// test assertions
+	if c.SubCommandCount() != 2 {
+		t.Error("Expecting c.SubCommandCount() == 2, got ", c.SubCommandCount())
+	}
+
+	// Execute the prepared SubCommands without adding them again
+	var vo = MacroCommandTestVO{Input: 5}
+	c.Execute(observer.NewNotification("MacroCommandPreparedTest", &vo, ""))
+	if vo.Result1 != 10 || vo.Result2 != 25 {
+		t.Error("Expecting vo.Result1 == 10 and vo.Result2 == 25")
+	}
+	if c.initialized != 1 || c.SubCommandCount() != 0 {
+		t.Error("Expecting a single initialization, got ", c.initialized)
+	}
+}
+
+/*
+Tests a MacroCommand whose SubCommands depend on the notification type,
+executed by the Controller with the inherited Execute.
+*/
+func TestMacroCommandInitializeWith(t *testing.T) {
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	c.RegisterCommand("MacroCommandTypedTest", func() interfaces.ICommand { return NewMacroCommandTestTypedCommand() })
+	defer c.RemoveCommand("MacroCommandTypedTest")
+
+	var one = MacroCommandTestVO{Input: 5}
+	v.NotifyObservers(observer.NewNotification("MacroCommandTypedTest", &one, "one"))
+
+	// test assertions
+	if one.Result1 != 10 || one.Result2 != 0 {
+		t.Error("Expecting only the first SubCommand to execute for type 'one'")
+	}
+
+	var both = MacroCommandTestVO{Input: 5}
+	v.NotifyObservers(observer.NewNotification("MacroCommandTypedTest", &both, "both"))
+
+	if both.Result1 != 10 || both.Result2 != 25 {
+		t.Error("Expecting both SubCommands to execute for type 'both'")
+	}
+}
@@ -0,0 +1,30 @@
+//
+//  SimpleCommandTestCompleteCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+SimpleCommandTestCompleteCommand A SimpleCommand subclass used by SimpleCommandTest.
+*/
+type SimpleCommandTestCompleteCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Complete with the input multiplied by 2
+
+- parameter note: the Notification carrying the input int
+*/
+func (self *SimpleCommandTestCompleteCommand) Execute(notification interfaces.INotification) {
+	self.Complete(2 * notification.Body().(int))
+}
@@ -0,0 +1,18 @@
+//
+//  SimpleCommandTestDefaultCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+
+/*
+SimpleCommandTestDefaultCommand A SimpleCommand subclass that does not override Execute.
+*/
+type SimpleCommandTestDefaultCommand struct {
+	command.SimpleCommand
+}
@@ -9,6 +9,10 @@
 package command
 
 import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
 	"testing"
 )
@@ -47,3 +51,45 @@ func TestSimpleCommandExecute(t *testing.T) {
 		t.Error("Expecting vo.result == 10")
 	}
 }
+
+/*
+Tests that a SimpleCommand subclass not overriding Execute
+is an ICommand and executes harmlessly.
+*/
+func TestSimpleCommandDefaultExecute(t *testing.T) {
+	vo := SimpleCommandTestVO{Input: 5}
+	var note = observer.NewNotification("SimpleCommandTestNote", &vo, "")
+
+	var command interfaces.ICommand = &SimpleCommandTestDefaultCommand{}
+	command.Execute(note)
+
+	// test assertions
+	if vo.Result != 0 {
+		t.Error("Expecting vo.result == 0")
+	}
+}
+
+/*
+Tests that Complete sends the completion notification
+with the result as its body.
+*/
+func TestSimpleCommandComplete(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("SimpleCommandTestCompleteNote", func() interfaces.ICommand {
+		return &SimpleCommandTestCompleteCommand{command.SimpleCommand{CompletionName: "SimpleCommandTestCompleteDone"}}
+	})
+	defer f.RemoveCommand("SimpleCommandTestCompleteNote")
+
+	var result interface{}
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	var capture = &observer.Observer{Notify: func(notification interfaces.INotification) { result = notification.Body() }, Context: &result}
+	v.RegisterObserver("SimpleCommandTestCompleteDone", capture)
+	defer v.RemoveObserver("SimpleCommandTestCompleteDone", &result)
+
+	f.SendNotification("SimpleCommandTestCompleteNote", 5, "")
+
+	// test assertions
+	if result != 10 {
+		t.Error("Expecting result == 10")
+	}
+}
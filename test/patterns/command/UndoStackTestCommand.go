@@ -0,0 +1,45 @@
+//
+//  UndoStackTestCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+UndoStackTestCommand An undoable SimpleCommand subclass used by UndoStackTest.
+
+The note type names the command in the log of the UndoStackTestVO.
+*/
+type UndoStackTestCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Add 10 to the value and log the execution.
+
+- parameter note: the note carrying the UndoStackTestVO
+*/
+func (self *UndoStackTestCommand) Execute(notification interfaces.INotification) {
+	var vo = notification.Body().(*UndoStackTestVO)
+	vo.Value += 10
+	vo.Log = append(vo.Log, "execute "+notification.Type())
+}
+
+/*
+Undo Subtract 10 from the value and log the undo.
+
+- parameter note: the note carrying the UndoStackTestVO
+*/
+func (self *UndoStackTestCommand) Undo(notification interfaces.INotification) {
+	var vo = notification.Body().(*UndoStackTestVO)
+	vo.Value -= 10
+	vo.Log = append(vo.Log, "undo "+notification.Type())
+}
@@ -0,0 +1,17 @@
+//
+//  UndoStackTestVO.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+/*
+UndoStackTestVO A utility class used by UndoStackTest.
+*/
+type UndoStackTestVO struct {
+	Value int
+	Log   []string
+}
@@ -0,0 +1,54 @@
+//
+//  UndoStack_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package command
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"strings"
+	"testing"
+)
+
+/*
+Test the PureMVC UndoStack class.
+*/
+
+/*
+Tests that recorded commands are undone in reverse order.
+*/
+func TestUndoStack(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var stack = &command.UndoStack{}
+	f.RegisterCommand("UndoStackTestNote", stack.Record(func() interfaces.IUndoableCommand { return &UndoStackTestCommand{} }))
+
+	var vo = UndoStackTestVO{}
+	f.SendNotification("UndoStackTestNote", &vo, "first")
+	f.SendNotification("UndoStackTestNote", &vo, "second")
+
+	if vo.Value != 20 || stack.Len() != 2 {
+		t.Error("Expecting vo.Value == 20 and stack.Len() == 2")
+	}
+
+	stack.UndoAll()
+
+	// test assertions
+	var log = strings.Join(vo.Log, ", ")
+	if log != "execute first, execute second, undo second, undo first" {
+		t.Error("Expecting log == 'execute first, execute second, undo second, undo first', got ", log)
+	}
+	if vo.Value != 0 || stack.Len() != 0 {
+		t.Error("Expecting vo.Value == 0 and stack.Len() == 0")
+	}
+	if stack.Undo() {
+		t.Error("Expecting stack.Undo() == false")
+	}
+
+	f.RemoveCommand("UndoStackTestNote")
+}
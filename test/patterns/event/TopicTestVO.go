@@ -0,0 +1,17 @@
+//
+//  TopicTestVO.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package event
+
+/*
+TopicTestVO A utility class used by TopicTest.
+*/
+type TopicTestVO struct {
+	Name  string
+	Count int
+}
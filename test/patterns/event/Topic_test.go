@@ -0,0 +1,51 @@
+//
+//  Topic_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package event
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/event"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"testing"
+)
+
+/*
+Test the PureMVC Topic class.
+*/
+
+/*
+Tests that a published payload reaches the subscriber with its type.
+*/
+func TestPublishAndSubscribe(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	var topic = event.NewTopic[TopicTestVO]("TopicTest")
+
+	var received []TopicTestVO
+	var subscriber = topic.Subscribe(v, func(payload TopicTestVO) {
+		received = append(received, payload)
+	})
+
+	topic.Publish(f, TopicTestVO{Name: "typed", Count: 2})
+
+	// a body of another type is ignored
+	f.SendNotification("TopicTest", "untyped", "")
+
+	// test assertions
+	if len(received) != 1 || received[0].Name != "typed" || received[0].Count != 2 {
+		t.Error("Expecting received == [{typed 2}], got ", received)
+	}
+
+	topic.Unsubscribe(v, subscriber)
+	topic.Publish(f, TopicTestVO{Name: "unsubscribed"})
+	if len(received) != 1 {
+		t.Error("Expecting len(received) == 1")
+	}
+}
@@ -0,0 +1,32 @@
+//
+//  FacadeTestCountCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+FacadeTestCountCommand A SimpleCommand subclass used by FacadeTest.
+*/
+type FacadeTestCountCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Count the executions in the result
+
+- parameter note: the Notification carrying the FacadeTestVO
+*/
+func (self *FacadeTestCountCommand) Execute(notification interfaces.INotification) {
+	var vo = notification.Body().(*FacadeTestVO)
+
+	vo.Result++
+}
@@ -0,0 +1,43 @@
+//
+//  FacadeTestDeferCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+const FACADE_TEST_DEFER = "FacadeDeferTest"
+const FACADE_TEST_DEFER_FOLLOW_UP = "FacadeDeferFollowUpTest"
+
+/*
+FacadeTestDeferCommand A SimpleCommand subclass used by FacadeTest.
+*/
+type FacadeTestDeferCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Log the execution, deferring a follow-up notification
+when handling FACADE_TEST_DEFER.
+
+- parameter note: the Notification carrying the *[]string log
+*/
+func (self *FacadeTestDeferCommand) Execute(notification interfaces.INotification) {
+	var log = notification.Body().(*[]string)
+
+	if notification.Name() == FACADE_TEST_DEFER_FOLLOW_UP {
+		*log = append(*log, "follow-up")
+		return
+	}
+
+	*log = append(*log, "execute")
+	self.Facade.SendNotificationDeferred(FACADE_TEST_DEFER_FOLLOW_UP, log, "")
+	*log = append(*log, "return")
+}
@@ -0,0 +1,30 @@
+//
+//  FacadeTestForwardCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+FacadeTestForwardCommand A SimpleCommand subclass used by FacadeTest.
+*/
+type FacadeTestForwardCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Send the body of the Notification on with its type as the name
+
+- parameter note: the Notification naming the Notification to send
+*/
+func (self *FacadeTestForwardCommand) Execute(notification interfaces.INotification) {
+	self.SendNotification(notification.Type(), notification.Body(), "")
+}
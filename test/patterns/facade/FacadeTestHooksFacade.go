@@ -0,0 +1,39 @@
+//
+//  FacadeTestHooksFacade.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/controller"
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/model"
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+)
+
+/*
+FacadeTestHooksFacade A Facade subclass used by FacadeTest.
+*/
+type FacadeTestHooksFacade struct {
+	facade.Facade
+	Log []string
+}
+
+func (self *FacadeTestHooksFacade) PreInitialize() {
+	self.Log = append(self.Log, "pre")
+}
+
+func (self *FacadeTestHooksFacade) InitializeFacade() {
+	self.Log = append(self.Log, "initialize")
+	self.Facade.InitializeFacade()
+}
+
+func (self *FacadeTestHooksFacade) PostInitialize() {
+	if controller.HasInstance() && model.HasInstance() && view.HasInstance() {
+		self.Log = append(self.Log, "post")
+	}
+}
@@ -0,0 +1,23 @@
+//
+//  FacadeTestMediator.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+
+/*
+FacadeTestMediator A Mediator class used by FacadeTest.
+*/
+type FacadeTestMediator struct {
+	mediator.Mediator
+	OnRemoveCalled bool
+}
+
+func (mediator *FacadeTestMediator) OnRemove() {
+	mediator.OnRemoveCalled = true
+}
@@ -0,0 +1,24 @@
+//
+//  FacadeTestProxy.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import "github.com/puremvc/puremvc-go-standard-framework/src/patterns/proxy"
+
+const FACADE_TEST_ON_REMOVE_CALLED = "onRemoveCalled"
+
+/*
+FacadeTestProxy A Proxy class used by FacadeTest.
+*/
+type FacadeTestProxy struct {
+	proxy.Proxy
+}
+
+func (proxy *FacadeTestProxy) OnRemove() {
+	proxy.SetData(FACADE_TEST_ON_REMOVE_CALLED)
+}
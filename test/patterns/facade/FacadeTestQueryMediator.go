@@ -0,0 +1,32 @@
+//
+//  FacadeTestQueryMediator.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+)
+
+const FACADE_TEST_QUERY = "FacadeQueryTest"
+
+/*
+FacadeTestQueryMediator A Mediator class used by FacadeTest.
+*/
+type FacadeTestQueryMediator struct {
+	mediator.Mediator
+	Answer interface{}
+}
+
+func (mediator *FacadeTestQueryMediator) ListNotificationInterests() []string {
+	return []string{FACADE_TEST_QUERY}
+}
+
+func (mediator *FacadeTestQueryMediator) Respond(notification interfaces.INotification) interface{} {
+	return mediator.Answer
+}
@@ -0,0 +1,32 @@
+//
+//  FacadeTestRecordCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+/*
+FacadeTestRecordCommand A SimpleCommand subclass used by FacadeTest.
+*/
+type FacadeTestRecordCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Record the name of the Notification in the body
+
+- parameter note: the Notification carrying the names recorded so far
+*/
+func (self *FacadeTestRecordCommand) Execute(notification interfaces.INotification) {
+	var names = notification.Body().(*[]string)
+
+	*names = append(*names, notification.Name())
+}
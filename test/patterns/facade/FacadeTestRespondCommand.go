@@ -0,0 +1,33 @@
+//
+//  FacadeTestRespondCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+)
+
+/*
+FacadeTestRespondCommand A SimpleCommand subclass used by FacadeTest.
+*/
+type FacadeTestRespondCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Respond with the input multiplied by 2
+
+- parameter note: the Notification carrying the input int
+*/
+func (self *FacadeTestRespondCommand) Execute(notification interfaces.INotification) {
+	var input = notification.Body().(int)
+
+	self.SendNotification(notification.Name()+facade.AWAIT_RESPONSE_SUFFIX, 2*input, notification.Type())
+}
@@ -0,0 +1,36 @@
+//
+//  FacadeTestSequenceCommand.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+)
+
+const FACADE_TEST_SEQUENCE = "FacadeSequenceTest"
+const FACADE_TEST_SEQUENCE_FOLLOW_UP = "FacadeSequenceFollowUpTest"
+
+/*
+FacadeTestSequenceCommand A SimpleCommand subclass used by FacadeTest.
+*/
+type FacadeTestSequenceCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Execute Send a follow-up notification, then log the return.
+
+- parameter note: the Notification carrying the *[]string log
+*/
+func (self *FacadeTestSequenceCommand) Execute(notification interfaces.INotification) {
+	var log = notification.Body().(*[]string)
+
+	self.Facade.SendNotification(FACADE_TEST_SEQUENCE_FOLLOW_UP, log, "")
+	*log = append(*log, "return")
+}
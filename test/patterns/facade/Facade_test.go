@@ -9,11 +9,21 @@
 package facade
 
 import (
+	"errors"
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/controller"
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/model"
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/proxy"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 /*
@@ -241,3 +251,836 @@ func TestHasCommand(t *testing.T) {
 		t.Error("Expecting facade.HasCommand('facadeHasCommandTest') == false")
 	}
 }
+
+/*
+Tests that the Command, Proxy and Mediator counts follow registrations.
+*/
+func TestCounts(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var commands, proxies, mediators = f.CommandCount(), f.ProxyCount(), f.MediatorCount()
+
+	f.RegisterCommand("facadeCountsCommand", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	f.RegisterProxy(&proxy.Proxy{Name: "facadeCountsProxy"})
+	f.RegisterMediator(&mediator.Mediator{Name: "facadeCountsMediator"})
+
+	// test assertions
+	if f.CommandCount() != commands+1 {
+		t.Error("Expecting facade.CommandCount() to increment on registration")
+	}
+	if f.ProxyCount() != proxies+1 {
+		t.Error("Expecting facade.ProxyCount() to increment on registration")
+	}
+	if f.MediatorCount() != mediators+1 {
+		t.Error("Expecting facade.MediatorCount() to increment on registration")
+	}
+
+	f.RemoveCommand("facadeCountsCommand")
+	f.RemoveProxy("facadeCountsProxy")
+	f.RemoveMediator("facadeCountsMediator")
+
+	// test assertions
+	if f.CommandCount() != commands {
+		t.Error("Expecting facade.CommandCount() to decrement on removal")
+	}
+	if f.ProxyCount() != proxies {
+		t.Error("Expecting facade.ProxyCount() to decrement on removal")
+	}
+	if f.MediatorCount() != mediators {
+		t.Error("Expecting facade.MediatorCount() to decrement on removal")
+	}
+}
+
+/*
+Tests that DumpState mentions every registered Command, Proxy, Mediator and Observer.
+*/
+func TestDumpState(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("facadeDumpStateCommand", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	f.RegisterProxy(&proxy.Proxy{Name: "facadeDumpStateProxy"})
+	f.RegisterMediator(&mediator.Mediator{Name: "facadeDumpStateMediator"})
+
+	var state = f.DumpState()
+
+	// test assertions
+	for _, name := range []string{"facadeDumpStateCommand", "facadeDumpStateProxy", "facadeDumpStateMediator", "facadeDumpStateCommand (1)"} {
+		if strings.Contains(state, name) == false {
+			t.Error("Expecting DumpState() to contain ", name)
+		}
+	}
+
+	// dumping the state leaves it untouched
+	if f.HasCommand("facadeDumpStateCommand") == false || f.HasProxy("facadeDumpStateProxy") == false || f.HasMediator("facadeDumpStateMediator") == false {
+		t.Error("Expecting registrations to remain after DumpState()")
+	}
+
+	f.RemoveCommand("facadeDumpStateCommand")
+	f.RemoveProxy("facadeDumpStateProxy")
+	f.RemoveMediator("facadeDumpStateMediator")
+}
+
+/*
+Tests that Shutdown removes every registration, calls the
+lifecycle hooks and resets the Singleton instances.
+*/
+func TestShutdown(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var p = &FacadeTestProxy{proxy.Proxy{Name: "facadeShutdownProxy"}}
+	var m = &FacadeTestMediator{Mediator: mediator.Mediator{Name: "facadeShutdownMediator"}}
+	f.RegisterCommand("facadeShutdownCommand", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	f.RegisterProxy(p)
+	f.RegisterMediator(m)
+
+	f.Shutdown()
+
+	// test assertions
+	if f.HasCommand("facadeShutdownCommand") || f.HasProxy("facadeShutdownProxy") || f.HasMediator("facadeShutdownMediator") {
+		t.Error("Expecting no registrations after Shutdown()")
+	}
+	if f.DumpState() != "Commands: \nProxies: \nMediators: \nObservers: " {
+		t.Error("Expecting empty DumpState() after Shutdown(), got ", f.DumpState())
+	}
+	if p.GetData() != FACADE_TEST_ON_REMOVE_CALLED {
+		t.Error("Expecting p.GetData() == FACADE_TEST_ON_REMOVE_CALLED")
+	}
+	if m.OnRemoveCalled != true {
+		t.Error("Expecting m.OnRemoveCalled == true")
+	}
+
+	// the framework starts afresh
+	var restarted = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	if restarted == f {
+		t.Error("Expecting a new Facade instance after Shutdown()")
+	}
+	if restarted.HasCommand("facadeShutdownCommand") {
+		t.Error("Expecting restarted.HasCommand('facadeShutdownCommand') == false")
+	}
+
+	// an isolated facade keeps its actors, emptied of prefix commands and observers
+	var v = view.NewView()
+	var c = controller.NewController(v)
+	var isolated = facade.NewFacadeWith(model.NewModel(), v, c)
+	c.RegisterCommandPrefix("facadeShutdownPrefix.", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	v.RegisterPrefixObserver("facadeShutdownObserver.", &observer.Observer{Notify: func(notification interfaces.INotification) {}, Context: v})
+	v.RegisterGlobalObserver(&observer.Observer{Notify: func(notification interfaces.INotification) {}, Context: v})
+
+	isolated.Shutdown()
+
+	if len(c.ListCommandPrefixes()) != 0 || len(v.ListObserverPrefixes()) != 0 {
+		t.Error("Expecting no prefix commands or observers after Shutdown(), got ", c.ListCommandPrefixes(), v.ListObserverPrefixes())
+	}
+}
+
+/*
+Tests that Notifications enqueued from several goroutines
+are each dispatched exactly once.
+*/
+func TestNotificationQueue(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("facadeQueueTest", func() interfaces.ICommand { return &FacadeTestCountCommand{} })
+
+	f.EnableNotificationQueue()
+	defer f.DisableNotificationQueue()
+
+	var vos = make([]FacadeTestVO, 100)
+	var wg sync.WaitGroup
+	for producer := 0; producer < 10; producer++ {
+		wg.Add(1)
+		go func(producer int) {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				f.SendNotification("facadeQueueTest", &vos[producer*10+i], "")
+			}
+		}(producer)
+	}
+	wg.Wait()
+
+	f.DrainNotifications()
+
+	// test assertions
+	for i := range vos {
+		if vos[i].Result != 1 {
+			t.Error("Expecting vos[i].Result == 1, got ", vos[i].Result)
+		}
+	}
+
+	f.RemoveCommand("facadeQueueTest")
+}
+
+/*
+Tests that toggling the queue while several goroutines send keeps
+the Notifications of each goroutine in the order they were sent.
+*/
+func TestStressNotificationQueueToggle(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	const producers, sends = 4, 200
+	var mutex sync.Mutex
+	var received = make([][]int, producers)
+	var context = &FacadeTestVO{}
+	v.RegisterObserver("facadeQueueToggleTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		var sent = notification.Body().([2]int)
+		mutex.Lock()
+		received[sent[0]] = append(received[sent[0]], sent[1])
+		mutex.Unlock()
+	}, Context: context})
+	defer v.RemoveObserver("facadeQueueToggleTest", context)
+
+	var done = make(chan struct{})
+	var toggled sync.WaitGroup
+	toggled.Add(1)
+	go func() {
+		defer toggled.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				f.EnableNotificationQueue()
+				f.DisableNotificationQueue()
+				f.EnableNotificationQueue()
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for producer := 0; producer < producers; producer++ {
+		wg.Add(1)
+		go func(producer int) {
+			defer wg.Done()
+			for i := 0; i < sends; i++ {
+				f.SendNotification("facadeQueueToggleTest", [2]int{producer, i}, "")
+			}
+		}(producer)
+	}
+	wg.Wait()
+	close(done)
+	toggled.Wait()
+	f.DisableNotificationQueue()
+
+	// test assertions
+	for producer, sequence := range received {
+		if len(sequence) != sends {
+			t.Error("Expecting every notification of producer ", producer, " once, got ", len(sequence))
+			continue
+		}
+		for i, n := range sequence {
+			if n != i {
+				t.Error("Expecting the notifications of producer ", producer, " in order, got ", n, " at ", i)
+				break
+			}
+		}
+	}
+}
+
+/*
+Tests that a middleware can drop a Notification while letting others through.
+*/
+func TestUseMiddleware(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("facadeBlockedNote", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	f.RegisterCommand("facadeAllowedNote", func() interfaces.ICommand { return &FacadeTestCommand{} })
+
+	var seen []string
+	f.Use(func(notification interfaces.INotification, next func()) {
+		seen = append(seen, notification.Name())
+		if notification.Name() != "facadeBlockedNote" {
+			next()
+		}
+	})
+
+	var blocked, allowed = FacadeTestVO{Input: 32}, FacadeTestVO{Input: 32}
+	f.SendNotification("facadeBlockedNote", &blocked, "")
+	f.SendNotification("facadeAllowedNote", &allowed, "")
+
+	// test assertions
+	if blocked.Result != 0 {
+		t.Error("Expecting blocked.Result == 0")
+	}
+	if allowed.Result != 64 {
+		t.Error("Expecting allowed.Result == 64")
+	}
+	if len(seen) != 2 {
+		t.Error("Expecting the middleware to see both notifications")
+	}
+
+	// start afresh, discarding the middleware
+	f.Shutdown()
+}
+
+/*
+Tests that a Notification body of the wrong type is reported in debug mode.
+*/
+func TestRegisterNotificationBodyType(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterNotificationBodyType("FacadeBodyTypeTest", &FacadeTestVO{})
+
+	var send = func(body interface{}) (report interface{}) {
+		defer func() { report = recover() }()
+		f.SendNotification("FacadeBodyTypeTest", body, "")
+		return nil
+	}
+
+	// outside of debug mode the body is not checked
+	if send("wrong") != nil {
+		t.Error("Expecting no report outside of debug mode")
+	}
+
+	debug.SetDebugMode(true)
+	defer debug.SetDebugMode(false)
+
+	// test assertions
+	if report := send("wrong"); report == nil || !strings.Contains(report.(string), "FacadeBodyTypeTest") {
+		t.Error("Expecting the mismatch to be reported, got ", report)
+	}
+	if report := send(&FacadeTestVO{}); report != nil {
+		t.Error("Expecting no report for the registered type, got ", report)
+	}
+}
+
+/*
+Tests checking for the Singleton instances without creating them.
+*/
+func TestHasInstance(t *testing.T) {
+	facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} }).Shutdown()
+
+	// test assertions
+	if facade.HasInstance() || controller.HasInstance() || model.HasInstance() || view.HasInstance() {
+		t.Error("Expecting no instances after Shutdown()")
+	}
+
+	facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	if !facade.HasInstance() || !controller.HasInstance() || !model.HasInstance() || !view.HasInstance() {
+		t.Error("Expecting every instance after GetInstance()")
+	}
+}
+
+/*
+Tests awaiting the correlated response to a Notification.
+*/
+func TestSendNotificationAwait(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("FacadeAwaitTest", func() interfaces.ICommand { return &FacadeTestRespondCommand{} })
+
+	var first = f.SendNotificationAwait("FacadeAwaitTest", 5, "")
+	var second = f.SendNotificationAwait("FacadeAwaitTest", 8, "")
+
+	// test assertions
+	select {
+	case response := <-second:
+		if response.Body() != 16 {
+			t.Error("Expecting response.Body() == 16, got ", response.Body())
+		}
+	case <-time.After(time.Second):
+		t.Error("Expecting a response to the second notification")
+	}
+	select {
+	case response := <-first:
+		if response.Body() != 10 {
+			t.Error("Expecting response.Body() == 10, got ", response.Body())
+		}
+	case <-time.After(time.Second):
+		t.Error("Expecting a response to the first notification")
+	}
+
+	// the observers awaiting the responses are removed
+	if strings.Contains(f.DumpState(), "FacadeAwaitTest"+facade.AWAIT_RESPONSE_SUFFIX) {
+		t.Error("Expecting no observers of 'FacadeAwaitTest/done'")
+	}
+
+	f.RemoveCommand("FacadeAwaitTest")
+}
+
+/*
+Tests that a deferred Notification is processed after the triggering Command returns.
+*/
+func TestSendNotificationDeferred(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand(FACADE_TEST_DEFER, func() interfaces.ICommand { return &FacadeTestDeferCommand{} })
+	f.RegisterCommand(FACADE_TEST_DEFER_FOLLOW_UP, func() interfaces.ICommand { return &FacadeTestDeferCommand{} })
+
+	var log []string
+	f.SendNotification(FACADE_TEST_DEFER, &log, "")
+
+	// test assertions
+	if strings.Join(log, ", ") != "execute, return, follow-up" {
+		t.Error("Expecting log == 'execute, return, follow-up', got ", log)
+	}
+
+	// outside of a dispatch the notification is sent right away
+	log = nil
+	f.SendNotificationDeferred(FACADE_TEST_DEFER_FOLLOW_UP, &log, "")
+	if strings.Join(log, ", ") != "follow-up" {
+		t.Error("Expecting log == 'follow-up', got ", log)
+	}
+
+	// the transformer of a deferred notification may call back into the facade
+	var nested = 0
+	f.RegisterCommandFunc("facadeDeferNestedTest", func(notification interfaces.INotification) { nested++ })
+	defer f.RemoveCommand("facadeDeferNestedTest")
+	f.RegisterBodyTransformer(FACADE_TEST_DEFER_FOLLOW_UP, func(body interface{}) interface{} {
+		f.SendNotificationDeferred("facadeDeferNestedTest", nil, "")
+		return body
+	})
+	log = nil
+	f.SendNotification(FACADE_TEST_DEFER, &log, "")
+	if strings.Join(log, ", ") != "execute, return, follow-up" || nested != 1 {
+		t.Error("Expecting log == 'execute, return, follow-up' and nested == 1, got ", log, nested)
+	}
+
+	f.RemoveCommand(FACADE_TEST_DEFER)
+	f.RemoveCommand(FACADE_TEST_DEFER_FOLLOW_UP)
+}
+
+/*
+Tests that SendNotifications sends every Notification, in order.
+*/
+func TestSendNotifications(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var names = []string{"facadeBatchTest1", "facadeBatchTest2", "facadeBatchTest3"}
+	for _, name := range names {
+		f.RegisterCommand(name, func() interfaces.ICommand { return &FacadeTestRecordCommand{} })
+		defer f.RemoveCommand(name)
+	}
+
+	var recorded []string
+	f.SendNotifications([]interfaces.INotification{
+		observer.NewNotification("facadeBatchTest1", &recorded, ""),
+		observer.NewNotification("facadeBatchTest2", &recorded, ""),
+		observer.NewNotification("facadeBatchTest3", &recorded, ""),
+	})
+
+	// test assertions
+	if strings.Join(recorded, ",") != strings.Join(names, ",") {
+		t.Error("Expecting recorded == [facadeBatchTest1 facadeBatchTest2 facadeBatchTest3], got ", recorded)
+	}
+}
+
+/*
+Tests that a Notification sent by a Command is fully handled
+before the Command's Execute resumes.
+
+Commands rely on this synchronous contract, for instance to read
+state that the observers of the Notification they sent updated.
+*/
+func TestSendNotificationFromCommandIsSynchronous(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand(FACADE_TEST_SEQUENCE, func() interfaces.ICommand { return &FacadeTestSequenceCommand{} })
+	f.RegisterCommand(FACADE_TEST_SEQUENCE_FOLLOW_UP, func() interfaces.ICommand { return &FacadeTestRecordCommand{} })
+	defer f.RemoveCommand(FACADE_TEST_SEQUENCE)
+	defer f.RemoveCommand(FACADE_TEST_SEQUENCE_FOLLOW_UP)
+
+	var log []string
+	f.SendNotification(FACADE_TEST_SEQUENCE, &log, "")
+
+	// test assertions
+	if strings.Join(log, ",") != FACADE_TEST_SEQUENCE_FOLLOW_UP+",return" {
+		t.Error("Expecting the follow-up to be handled before the command returns, got ", log)
+	}
+}
+
+/*
+Tests that scheduled Notifications are sent after their delay,
+unless cancelled or the Facade is shut down first.
+*/
+func TestSendNotificationAfter(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var received = make(chan string, 3)
+	var context = &FacadeTestVO{}
+	v.RegisterObserver("facadeAfterTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		received <- notification.Body().(string)
+	}, Context: context})
+
+	f.SendNotificationAfter("facadeAfterTest", "sent", "", 10*time.Millisecond)
+	var cancel = f.SendNotificationAfter("facadeAfterTest", "cancelled", "", 10*time.Millisecond)
+	if !cancel() {
+		t.Error("Expecting cancel() == true for a pending notification")
+	}
+
+	// test assertions
+	select {
+	case body := <-received:
+		if body != "sent" {
+			t.Error("Expecting body == 'sent', got ", body)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expecting the scheduled notification to be sent")
+	}
+	if cancel() {
+		t.Error("Expecting cancel() == false once cancelled")
+	}
+
+	// pending notifications are cancelled on shutdown
+	f.SendNotificationAfter("facadeAfterTest", "shut down", "", 20*time.Millisecond)
+	f.Shutdown()
+
+	select {
+	case body := <-received:
+		t.Error("Expecting no notification after Shutdown(), got ", body)
+	case <-time.After(60 * time.Millisecond):
+	}
+	v.RemoveObserver("facadeAfterTest", context)
+}
+
+/*
+Tests that a query collects the results of every query observer.
+*/
+func TestQuery(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterMediator(&FacadeTestQueryMediator{Mediator: mediator.Mediator{Name: "facadeQueryTest1"}, Answer: "one"})
+	f.RegisterMediator(&FacadeTestQueryMediator{Mediator: mediator.Mediator{Name: "facadeQueryTest2"}, Answer: 2})
+	f.RegisterMediator(&FacadeTestMediator{Mediator: mediator.Mediator{Name: "facadeQueryTest3"}})
+	defer f.RemoveMediator("facadeQueryTest1")
+	defer f.RemoveMediator("facadeQueryTest2")
+	defer f.RemoveMediator("facadeQueryTest3")
+
+	var results = f.Query(FACADE_TEST_QUERY, nil)
+
+	// test assertions
+	if len(results) != 2 || results[0] != "one" || results[1] != 2 {
+		t.Error("Expecting results == [one 2], got ", results)
+	}
+}
+
+/*
+Tests that PreInitialize and PostInitialize are called around InitializeFacade.
+*/
+func TestInitializeHooks(t *testing.T) {
+	facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} }).Shutdown()
+
+	var f = facade.GetInstance(func() interfaces.IFacade { return &FacadeTestHooksFacade{} }).(*FacadeTestHooksFacade)
+	defer f.Shutdown()
+
+	// test assertions
+	if strings.Join(f.Log, ",") != "pre,initialize,post" {
+		t.Error("Expecting f.Log == [pre initialize post], got ", f.Log)
+	}
+}
+
+/*
+Tests that a func registered with RegisterCommandFunc handles its Notification.
+*/
+func TestRegisterCommandFunc(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommandFunc("facadeCommandFuncTest", func(notification interfaces.INotification) {
+		var vo = notification.Body().(*FacadeTestVO)
+		vo.Result = 3 * vo.Input
+	})
+	defer f.RemoveCommand("facadeCommandFuncTest")
+
+	var vo = FacadeTestVO{Input: 4}
+	f.SendNotification("facadeCommandFuncTest", &vo, "")
+
+	// test assertions
+	if vo.Result != 12 {
+		t.Error("Expecting vo.Result == 12")
+	}
+}
+
+/*
+Tests that body transformers rewrite the body, chained in registration order.
+*/
+func TestRegisterBodyTransformer(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("facadeTransformerTest", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	defer f.RemoveCommand("facadeTransformerTest")
+
+	var vo *FacadeTestVO
+	f.RegisterBodyTransformer("facadeTransformerTest", func(body interface{}) interface{} {
+		vo = &FacadeTestVO{Input: body.(int)}
+		return vo
+	})
+	f.RegisterBodyTransformer("facadeTransformerTest", func(body interface{}) interface{} {
+		body.(*FacadeTestVO).Input++
+		return body
+	})
+
+	f.SendNotification("facadeTransformerTest", 31, "")
+
+	// test assertions
+	if vo == nil || vo.Result != 64 {
+		t.Error("Expecting the command to see the transformed body and vo.Result == 64")
+	}
+
+	// a notification sent again is transformed from its original body
+	var note = observer.NewNotification("facadeTransformerTest", 31, "")
+	f.SendNotifications([]interfaces.INotification{note, note})
+	if note.Body() != 31 || vo.Result != 64 {
+		t.Error("Expecting note.Body() == 31 and vo.Result == 64, got ", note.Body(), vo.Result)
+	}
+}
+
+/*
+Tests that a ReadOnlyFacade sends and looks up, but cannot register or remove.
+*/
+func TestReadOnly(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("facadeReadOnlyCommand", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	f.RegisterProxy(&proxy.Proxy{Name: "facadeReadOnlyProxy"})
+	f.RegisterMediator(&mediator.Mediator{Name: "facadeReadOnlyMediator"})
+	defer f.RemoveCommand("facadeReadOnlyCommand")
+	defer f.RemoveProxy("facadeReadOnlyProxy")
+	defer f.RemoveMediator("facadeReadOnlyMediator")
+
+	var plugin = f.(*facade.Facade).ReadOnly()
+
+	// test assertions
+	if !plugin.HasCommand("facadeReadOnlyCommand") || !plugin.HasProxy("facadeReadOnlyProxy") || !plugin.HasMediator("facadeReadOnlyMediator") {
+		t.Error("Expecting the read-only facade to see the registrations")
+	}
+	if plugin.RetrieveProxy("facadeReadOnlyProxy") == nil || plugin.RetrieveMediator("facadeReadOnlyMediator") == nil {
+		t.Error("Expecting the read-only facade to retrieve the proxy and mediator")
+	}
+
+	var vo = FacadeTestVO{Input: 5}
+	plugin.SendNotification("facadeReadOnlyCommand", &vo, "")
+	if vo.Result != 10 {
+		t.Error("Expecting vo.Result == 10")
+	}
+
+	var readOnlyType = reflect.TypeOf(plugin)
+	for i := 0; i < readOnlyType.NumMethod(); i++ {
+		var name = readOnlyType.Method(i).Name
+		if strings.HasPrefix(name, "Register") || strings.HasPrefix(name, "Remove") {
+			t.Error("Expecting no mutator on the read-only facade, found ", name)
+		}
+	}
+}
+
+/*
+Tests that OnUnhandledNotification receives Notifications no Observer handles.
+*/
+func TestOnUnhandledNotification(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} }).(*facade.Facade)
+	f.RegisterCommand("facadeHandledTest", func() interfaces.ICommand { return &FacadeTestCommand{} })
+	defer f.RemoveCommand("facadeHandledTest")
+
+	var unhandled []string
+	f.OnUnhandledNotification = func(notification interfaces.INotification) {
+		unhandled = append(unhandled, notification.Name())
+	}
+	defer func() { f.OnUnhandledNotification = nil }()
+
+	var vo = FacadeTestVO{Input: 1}
+	f.SendNotification("facadeHandledTest", &vo, "")
+	f.SendNotification("facadeUnhandledTest", &vo, "")
+
+	// test assertions
+	if len(unhandled) != 1 || unhandled[0] != "facadeUnhandledTest" {
+		t.Error("Expecting unhandled == [facadeUnhandledTest], got ", unhandled)
+	}
+	if vo.Result != 2 {
+		t.Error("Expecting the handled notification to reach its command")
+	}
+}
+
+/*
+Tests that a failed transaction removes what it registered.
+*/
+func TestTransaction(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+
+	var first = &FacadeTestProxy{proxy.Proxy{Name: "facadeTxProxy1"}}
+	var failure = errors.New("setup failed")
+	var err = f.Transaction(func(tx interfaces.IFacadeTx) error {
+		tx.RegisterProxy(first)
+		tx.RegisterProxy(&FacadeTestProxy{proxy.Proxy{Name: "facadeTxProxy2"}})
+		tx.RegisterCommand("facadeTxCommand", func() interfaces.ICommand { return &FacadeTestCommand{} })
+		return failure
+	})
+
+	// test assertions
+	if err != failure {
+		t.Error("Expecting the transaction to return the error of its func")
+	}
+	if f.HasProxy("facadeTxProxy1") || f.HasProxy("facadeTxProxy2") || f.HasCommand("facadeTxCommand") {
+		t.Error("Expecting no registration to remain after the rollback")
+	}
+	if first.GetData() != FACADE_TEST_ON_REMOVE_CALLED {
+		t.Error("Expecting OnRemove to be called on the rolled back proxy")
+	}
+
+	// a successful transaction keeps its registrations
+	err = f.Transaction(func(tx interfaces.IFacadeTx) error {
+		tx.RegisterProxy(&proxy.Proxy{Name: "facadeTxProxy3"})
+		return nil
+	})
+	if err != nil || !f.HasProxy("facadeTxProxy3") {
+		t.Error("Expecting the successful transaction to keep facadeTxProxy3")
+	}
+	f.RemoveProxy("facadeTxProxy3")
+}
+
+/*
+Tests that SendNotificationChecked reports whether a command handled the notification.
+*/
+func TestSendNotificationChecked(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterCommand("facadeCheckedTest", func() interfaces.ICommand { return &FacadeTestRecordCommand{} })
+	defer f.RemoveCommand("facadeCheckedTest")
+
+	var recorded []string
+
+	// test assertions
+	if !f.SendNotificationChecked("facadeCheckedTest", &recorded, "") || len(recorded) != 1 {
+		t.Error("Expecting the notification to be handled by the registered command")
+	}
+	if f.SendNotificationChecked("facadeCheckedMissing", &recorded, "") {
+		t.Error("Expecting the notification without a command not to be handled")
+	}
+}
+
+/*
+newIsolatedFacade Create a Facade bound to Core actors of its own.
+*/
+func newIsolatedFacade() interfaces.IFacade {
+	var v = view.NewView()
+	return facade.NewFacadeWith(model.NewModel(), v, controller.NewController(v))
+}
+
+/*
+Tests that two Facades created with NewFacadeWith do not share
+their registrations with each other or with the Singleton.
+*/
+func TestNewFacadeWith(t *testing.T) {
+	var f1 = newIsolatedFacade()
+	var f2 = newIsolatedFacade()
+
+	f1.RegisterProxy(&proxy.Proxy{Name: "facadeIsolatedProxy", Data: 1})
+	f2.RegisterProxy(&proxy.Proxy{Name: "facadeIsolatedProxy", Data: 2})
+	f1.RegisterMediator(&mediator.Mediator{Name: "facadeIsolatedMediator"})
+
+	var handled1, handled2 = 0, 0
+	f1.RegisterCommandFunc("facadeIsolatedNote", func(notification interfaces.INotification) { handled1++ })
+	f2.RegisterCommandFunc("facadeIsolatedNote", func(notification interfaces.INotification) { handled2++ })
+
+	f1.SendNotification("facadeIsolatedNote", nil, "")
+
+	// test assertions
+	if f1.RetrieveProxy("facadeIsolatedProxy").GetData() != 1 || f2.RetrieveProxy("facadeIsolatedProxy").GetData() != 2 {
+		t.Error("Expecting each facade to retrieve its own proxy")
+	}
+	if !f1.HasMediator("facadeIsolatedMediator") || f2.HasMediator("facadeIsolatedMediator") {
+		t.Error("Expecting the mediator to be registered with f1 only")
+	}
+	if handled1 != 1 || handled2 != 0 {
+		t.Error("Expecting the notification to reach the command of f1 only")
+	}
+
+	var singleton = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	if singleton.HasProxy("facadeIsolatedProxy") || singleton.HasCommand("facadeIsolatedNote") {
+		t.Error("Expecting the singleton facade to be unaffected")
+	}
+
+	f1.Shutdown()
+	if f1.HasProxy("facadeIsolatedProxy") || !f2.HasProxy("facadeIsolatedProxy") || !facade.HasInstance() {
+		t.Error("Expecting Shutdown to clear f1 only")
+	}
+	f2.Shutdown()
+}
+
+/*
+Tests that a sticky Notification is replayed to an observer
+and a mediator registered after it was sent.
+*/
+func TestEnableStickyNotification(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	f.EnableStickyNotification("facadeStickyTest")
+	defer f.DisableStickyNotification("facadeStickyTest")
+
+	f.SendNotification("facadeStickyTest", "first", "")
+	f.SendNotification("facadeStickyTest", "last", "")
+
+	var received []string
+	var context = &FacadeTestVO{}
+	v.RegisterObserver("facadeStickyTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		received = append(received, notification.Body().(string))
+	}, Context: context})
+	defer v.RemoveObserver("facadeStickyTest", context)
+
+	// test assertions
+	if len(received) != 1 || received[0] != "last" {
+		t.Error("Expecting received == [last], got ", received)
+	}
+
+	// a sticky notification nothing observes yet is kept even if reported as unhandled
+	var f2 = f.(*facade.Facade)
+	var unhandled = 0
+	f2.OnUnhandledNotification = func(notification interfaces.INotification) { unhandled++ }
+	f.EnableStickyNotification("facadeStickyUnhandled")
+	defer f.DisableStickyNotification("facadeStickyUnhandled")
+	f.SendNotification("facadeStickyUnhandled", 42, "")
+	f2.OnUnhandledNotification = nil
+
+	var answer interface{}
+	var unhandledContext = &FacadeTestVO{}
+	v.RegisterObserver("facadeStickyUnhandled", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		answer = notification.Body()
+	}, Context: unhandledContext})
+	defer v.RemoveObserver("facadeStickyUnhandled", unhandledContext)
+	if answer != 42 || unhandled != 1 {
+		t.Error("Expecting answer == 42 and unhandled == 1, got ", answer, unhandled)
+	}
+
+	var m = &mediator.MediatorRouter{Mediator: mediator.Mediator{Name: "facadeStickyMediator"}}
+	var routed string
+	m.Route("facadeStickyTest", func(notification interfaces.INotification) { routed = notification.Body().(string) })
+	f.RegisterMediator(m)
+	defer f.RemoveMediator("facadeStickyMediator")
+	if routed != "last" {
+		t.Error("Expecting routed == 'last', got ", routed)
+	}
+
+	f.DisableStickyNotification("facadeStickyTest")
+	var late = &FacadeTestVO{}
+	var replayed = false
+	v.RegisterObserver("facadeStickyTest", &observer.Observer{Notify: func(notification interfaces.INotification) {
+		replayed = true
+	}, Context: late})
+	defer v.RemoveObserver("facadeStickyTest", late)
+	if replayed {
+		t.Error("Expecting no replay once the notification is no longer sticky")
+	}
+}
+
+/*
+Tests that the commands, mediators and proxies registered with a
+Facade created with NewFacadeWith send their Notifications to it
+rather than to the Singleton.
+*/
+func TestNewFacadeWithNotifiers(t *testing.T) {
+	var f = newIsolatedFacade()
+	defer f.Shutdown()
+	var singleton = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+
+	var isolated, shared []interface{}
+	f.RegisterCommandFunc("facadeIsolatedSent", func(notification interfaces.INotification) {
+		isolated = append(isolated, notification.Body())
+	})
+	singleton.RegisterCommandFunc("facadeIsolatedSent", func(notification interfaces.INotification) {
+		shared = append(shared, notification.Body())
+	})
+	defer singleton.RemoveCommand("facadeIsolatedSent")
+
+	f.RegisterCommand("facadeIsolatedForward", func() interfaces.ICommand { return &FacadeTestForwardCommand{} })
+	f.SendNotification("facadeIsolatedForward", "command", "facadeIsolatedSent")
+
+	var p = &proxy.Proxy{Name: "facadeIsolatedSender"}
+	f.RegisterProxy(p)
+	p.SendNotification("facadeIsolatedSent", "proxy", "")
+
+	var m = &mediator.Mediator{Name: "facadeIsolatedSender"}
+	f.RegisterMediator(m)
+	m.SendNotification("facadeIsolatedSent", "mediator", "")
+
+	// test assertions
+	if !reflect.DeepEqual(isolated, []interface{}{"command", "proxy", "mediator"}) {
+		t.Error("Expecting isolated == [command proxy mediator], got ", isolated)
+	}
+	if len(shared) != 0 {
+		t.Error("Expecting the singleton facade to receive nothing, got ", shared)
+	}
+}
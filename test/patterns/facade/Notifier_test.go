@@ -0,0 +1,87 @@
+//
+//  Notifier_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/debug"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"strings"
+	"testing"
+)
+
+/*
+Test the PureMVC Notifier class.
+*/
+
+/*
+Tests assigning a body to a target of the matching type.
+*/
+func TestBodyAs(t *testing.T) {
+	var notifier = facade.Notifier{}
+	var body = &FacadeTestVO{Input: 7}
+
+	var vo *FacadeTestVO
+	var ok = notifier.BodyAs(observer.NewNotification("NotifierTest", body, ""), &vo)
+
+	// test assertions
+	if !ok || vo != body {
+		t.Error("Expecting ok == true and vo == body")
+	}
+}
+
+/*
+Tests that a nil body is not assigned.
+*/
+func TestBodyAsNilBody(t *testing.T) {
+	var notifier = facade.Notifier{}
+
+	var vo = &FacadeTestVO{}
+	var ok = notifier.BodyAs(observer.NewNotification("NotifierTest", nil, ""), &vo)
+
+	// test assertions
+	if ok || vo == nil {
+		t.Error("Expecting ok == false and vo unchanged")
+	}
+}
+
+/*
+Tests that a body of another type is not assigned.
+*/
+func TestBodyAsMismatchedType(t *testing.T) {
+	var notifier = facade.Notifier{}
+
+	var vo *FacadeTestVO
+	var ok = notifier.BodyAs(observer.NewNotification("NotifierTest", "text", ""), &vo)
+
+	// test assertions
+	if ok || vo != nil {
+		t.Error("Expecting ok == false and vo == nil")
+	}
+}
+
+/*
+Tests that sending before InitializeNotifier panics descriptively in debug mode.
+*/
+func TestSendNotificationBeforeInitializeNotifierInDebugMode(t *testing.T) {
+	var notifier = facade.Notifier{}
+
+	debug.SetDebugMode(true)
+	defer debug.SetDebugMode(false)
+
+	defer func() {
+		// test assertions
+		var report = recover()
+		if message, ok := report.(string); !ok || !strings.Contains(message, "InitializeNotifier") {
+			t.Error("Expecting a panic about InitializeNotifier, got ", report)
+		}
+	}()
+
+	notifier.SendNotification("NotifierTest", nil, "")
+}
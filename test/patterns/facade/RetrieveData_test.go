@@ -0,0 +1,72 @@
+//
+//  RetrieveData_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package facade
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/proxy"
+	"testing"
+)
+
+/*
+Test the PureMVC RetrieveData accessor.
+*/
+
+/*
+Tests retrieving the data of a registered proxy.
+*/
+func TestRetrieveProxyData(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterProxy(&proxy.Proxy{Name: "RetrieveDataTest", Data: []string{"red", "green"}})
+	defer f.RemoveProxy("RetrieveDataTest")
+
+	var data, ok = f.RetrieveProxyData("RetrieveDataTest")
+
+	// test assertions
+	if !ok || len(data.([]string)) != 2 {
+		t.Error("Expecting RetrieveProxyData to return the proxy data")
+	}
+
+	var colors, typed = facade.RetrieveData[[]string](f, "RetrieveDataTest")
+	if !typed || colors[1] != "green" {
+		t.Error("Expecting RetrieveData to return the proxy data as []string")
+	}
+}
+
+/*
+Tests retrieving the data of a proxy that is not registered.
+*/
+func TestRetrieveProxyDataAbsent(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+
+	// test assertions
+	if data, ok := f.RetrieveProxyData("RetrieveDataAbsentTest"); ok || data != nil {
+		t.Error("Expecting RetrieveProxyData to return nil, false")
+	}
+	if colors, ok := facade.RetrieveData[[]string](f, "RetrieveDataAbsentTest"); ok || colors != nil {
+		t.Error("Expecting RetrieveData to return nil, false")
+	}
+}
+
+/*
+Tests retrieving the data of a proxy as the wrong type.
+*/
+func TestRetrieveDataWrongType(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	f.RegisterProxy(&proxy.Proxy{Name: "RetrieveDataWrongTypeTest", Data: []string{"red"}})
+	defer f.RemoveProxy("RetrieveDataWrongTypeTest")
+
+	var count, ok = facade.RetrieveData[int](f, "RetrieveDataWrongTypeTest")
+
+	// test assertions
+	if ok || count != 0 {
+		t.Error("Expecting RetrieveData to return 0, false")
+	}
+}
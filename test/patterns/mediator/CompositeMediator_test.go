@@ -0,0 +1,92 @@
+//
+//  CompositeMediator_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package mediator
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+	"testing"
+)
+
+/*
+Test the PureMVC CompositeMediator class.
+*/
+
+/*
+Tests that the children are registered and removed with their parent.
+*/
+func TestCompositeMediator(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+
+	var parent = &mediator.CompositeMediator{Mediator: mediator.Mediator{Name: "CompositeParent"}}
+	parent.AddChild(&mediator.Mediator{Name: "CompositeChild1"})
+	parent.AddChild(&mediator.Mediator{Name: "CompositeChild2"})
+	f.RegisterMediator(parent)
+
+	// test assertions
+	if !f.HasMediator("CompositeParent") || !f.HasMediator("CompositeChild1") || !f.HasMediator("CompositeChild2") {
+		t.Error("Expecting the parent and both children to be registered")
+	}
+
+	f.RemoveMediator("CompositeParent")
+	if f.HasMediator("CompositeParent") || f.HasMediator("CompositeChild1") || f.HasMediator("CompositeChild2") {
+		t.Error("Expecting the parent and both children to be removed")
+	}
+}
+
+/*
+Tests that a child registered before its parent is left alone.
+*/
+func TestCompositeMediatorRegisteredChild(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+
+	var child = &mediator.Mediator{Name: "CompositeSharedChild"}
+	f.RegisterMediator(child)
+
+	var parent = &mediator.CompositeMediator{Mediator: mediator.Mediator{Name: "CompositeSharedParent"}}
+	parent.AddChild(&mediator.Mediator{Name: "CompositeSharedChild"})
+	f.RegisterMediator(parent)
+
+	// test assertions
+	if f.RetrieveMediator("CompositeSharedChild") != child {
+		t.Error("Expecting the registered child not to be replaced")
+	}
+
+	f.RemoveMediator("CompositeSharedParent")
+	if !f.HasMediator("CompositeSharedChild") {
+		t.Error("Expecting the registered child not to be removed with the parent")
+	}
+
+	f.RemoveMediator("CompositeSharedChild")
+}
+
+/*
+Tests that a mediator registered under the name of a removed child
+is not removed with the parent.
+*/
+func TestCompositeMediatorReplacedChild(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+
+	var parent = &mediator.CompositeMediator{Mediator: mediator.Mediator{Name: "CompositeReplacedParent"}}
+	parent.AddChild(&mediator.Mediator{Name: "CompositeReplacedChild"})
+	f.RegisterMediator(parent)
+
+	f.RemoveMediator("CompositeReplacedChild")
+	var unrelated = &mediator.Mediator{Name: "CompositeReplacedChild"}
+	f.RegisterMediator(unrelated)
+	defer f.RemoveMediator("CompositeReplacedChild")
+
+	f.RemoveMediator("CompositeReplacedParent")
+
+	// test assertions
+	if f.RetrieveMediator("CompositeReplacedChild") != unrelated {
+		t.Error("Expecting the unrelated mediator not to be removed with the parent")
+	}
+}
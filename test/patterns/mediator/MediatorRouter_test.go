@@ -0,0 +1,53 @@
+//
+//  MediatorRouter_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package mediator
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"testing"
+)
+
+/*
+Test the PureMVC MediatorRouter class.
+*/
+
+/*
+Tests that routed notifications are dispatched to their own handlers
+and that the routed names are listed as the mediator's interests.
+*/
+func TestRouteNotifications(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var first, second string
+	var m = &mediator.MediatorRouter{Mediator: mediator.Mediator{Name: "MediatorRouterTest"}}
+	m.Route("MediatorRouterNote1", func(notification interfaces.INotification) { first = notification.Body().(string) })
+	m.Route("MediatorRouterNote2", func(notification interfaces.INotification) { second = notification.Body().(string) })
+
+	var interests = m.ListNotificationInterests()
+	if len(interests) != 2 || interests[0] != "MediatorRouterNote1" || interests[1] != "MediatorRouterNote2" {
+		t.Error("Expecting interests == [MediatorRouterNote1 MediatorRouterNote2]")
+	}
+
+	v.RegisterMediator(m)
+
+	v.NotifyObservers(observer.NewNotification("MediatorRouterNote1", "one", ""))
+	if first != "one" || second != "" {
+		t.Error("Expecting first == 'one' and second == ''")
+	}
+
+	v.NotifyObservers(observer.NewNotification("MediatorRouterNote2", "two", ""))
+	if first != "one" || second != "two" {
+		t.Error("Expecting first == 'one' and second == 'two'")
+	}
+
+	v.RemoveMediator("MediatorRouterTest")
+}
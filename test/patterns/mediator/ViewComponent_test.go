@@ -0,0 +1,47 @@
+//
+//  ViewComponent_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package mediator
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/mediator"
+	"testing"
+)
+
+/*
+Test the PureMVC ViewComponent accessor.
+*/
+
+/*
+Tests getting a view component of the correct type.
+*/
+func TestViewComponent(t *testing.T) {
+	var component = &[]string{"button"}
+	var m = &mediator.Mediator{Name: "ViewComponentTest", ViewComponent: component}
+
+	var viewComponent, ok = mediator.ViewComponent[*[]string](m)
+
+	// test assertions
+	if !ok || viewComponent != component {
+		t.Error("Expecting ok == true and viewComponent == component")
+	}
+}
+
+/*
+Tests getting a view component of the wrong type.
+*/
+func TestViewComponentWrongType(t *testing.T) {
+	var m = &mediator.Mediator{Name: "ViewComponentTest", ViewComponent: "label"}
+
+	var viewComponent, ok = mediator.ViewComponent[int](m)
+
+	// test assertions
+	if ok || viewComponent != 0 {
+		t.Error("Expecting ok == false and viewComponent == 0")
+	}
+}
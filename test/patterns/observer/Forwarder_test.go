@@ -0,0 +1,93 @@
+//
+//  Forwarder_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/command"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"testing"
+)
+
+/*
+Test the PureMVC forwarding Observer.
+*/
+
+/*
+Tests that a Notification sent under the source name
+reaches the Command registered for the target name,
+with its body and type preserved.
+*/
+func TestForwarder(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	f.RegisterCommand("ForwarderTestTarget", func() interfaces.ICommand { return &ForwarderTestCommand{} })
+	var forwarder = observer.NewForwarder(f, "ForwarderTestTarget")
+	v.RegisterObserver("ForwarderTestSource", forwarder)
+
+	var vo = ForwarderTestVO{}
+	f.SendNotification("ForwarderTestSource", &vo, "forwarded")
+
+	// test assertions
+	if vo.Name != "ForwarderTestTarget" {
+		t.Error("Expecting vo.Name == 'ForwarderTestTarget'")
+	}
+	if vo.Type != "forwarded" {
+		t.Error("Expecting vo.Type == 'forwarded'")
+	}
+
+	v.RemoveObserver("ForwarderTestSource", forwarder)
+	f.RemoveCommand("ForwarderTestTarget")
+}
+
+/*
+Tests that a forwarder registered for its own target does not loop.
+*/
+func TestForwarderToItself(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	f.RegisterCommand("ForwarderTestLoop", func() interfaces.ICommand { return &ForwarderTestCommand{} })
+	var forwarder = observer.NewForwarder(f, "ForwarderTestLoop")
+	v.RegisterObserver("ForwarderTestLoop", forwarder)
+
+	var vo = ForwarderTestVO{}
+	f.SendNotification("ForwarderTestLoop", &vo, "")
+
+	// test assertions
+	if vo.Count != 1 {
+		t.Error("Expecting vo.Count == 1")
+	}
+
+	v.RemoveObserver("ForwarderTestLoop", forwarder)
+	f.RemoveCommand("ForwarderTestLoop")
+}
+
+type ForwarderTestVO struct {
+	Name  string
+	Type  string
+	Count int
+}
+
+type ForwarderTestCommand struct {
+	command.SimpleCommand
+}
+
+/*
+Record the Notification the Command was executed for.
+*/
+func (self *ForwarderTestCommand) Execute(notification interfaces.INotification) {
+	var vo = notification.Body().(*ForwarderTestVO)
+	vo.Name = notification.Name()
+	vo.Type = notification.Type()
+	vo.Count++
+}
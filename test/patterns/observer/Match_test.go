@@ -0,0 +1,53 @@
+//
+//  Match_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"testing"
+)
+
+/*
+Test the PureMVC Notification matcher.
+*/
+
+/*
+Tests matching a captured Notification by name, type and body.
+*/
+func TestMatch(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var captured interfaces.INotification
+	var capture = &observer.Observer{Notify: func(notification interfaces.INotification) { captured = notification }}
+	capture.Context = capture
+	v.RegisterObserver("MatchTest", capture)
+	v.NotifyObservers(observer.NewNotification("MatchTest", 42, "reading"))
+	v.RemoveObserver("MatchTest", capture)
+
+	var above30 = func(body interface{}) bool { return body.(int) > 30 }
+
+	// test assertions
+	if !observer.Match("MatchTest", "reading", above30)(captured) {
+		t.Error("Expecting the captured notification to match")
+	}
+	if !observer.Match("MatchTest", "", nil)(captured) {
+		t.Error("Expecting any type and any body to match")
+	}
+	if observer.Match("OtherTest", "", nil)(captured) {
+		t.Error("Expecting another name not to match")
+	}
+	if observer.Match("MatchTest", "other", nil)(captured) {
+		t.Error("Expecting another type not to match")
+	}
+	if observer.Match("MatchTest", "", func(body interface{}) bool { return body.(int) > 50 })(captured) {
+		t.Error("Expecting a body failing the matcher not to match")
+	}
+}
@@ -0,0 +1,73 @@
+//
+//  Namespace_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/controller"
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"testing"
+)
+
+/*
+Test the PureMVC Notification Namespace.
+*/
+
+/*
+Tests the names generated by a Namespace.
+*/
+func TestNamespaceNames(t *testing.T) {
+	var auth = observer.NewNamespace("auth")
+
+	// test assertions
+	if auth.Name("login") != "auth/login" {
+		t.Error("Expecting auth.Name('login') == 'auth/login'")
+	}
+	if auth.Prefix() != "auth/" {
+		t.Error("Expecting auth.Prefix() == 'auth/'")
+	}
+	if auth.Namespace("oauth").Name("token") != "auth/oauth/token" {
+		t.Error("Expecting a nested name == 'auth/oauth/token'")
+	}
+	if !auth.Contains("auth/oauth/token") || auth.Contains("authz/login") {
+		t.Error("Expecting auth to contain only the names under 'auth/'")
+	}
+}
+
+/*
+Tests that prefix registrations against a Namespace match its names.
+*/
+func TestNamespaceRegistration(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+	var c = controller.GetInstance(func() interfaces.IController { return &controller.Controller{} })
+	var auth = observer.NewNamespace("NamespaceTestAuth")
+
+	var received []string
+	var context = &Test{}
+	auth.RegisterObserver(v, &observer.Observer{Notify: func(notification interfaces.INotification) {
+		received = append(received, notification.Name())
+	}, Context: context})
+	defer v.RemovePrefixObserver(auth.Prefix(), context)
+
+	c.RegisterCommandPrefix(auth.Prefix(), func() interfaces.ICommand { return &ForwarderTestCommand{} })
+	defer c.RemoveCommandPrefix(auth.Prefix())
+
+	var vo = ForwarderTestVO{}
+	v.NotifyObservers(observer.NewNotification(auth.Name("login"), &vo, ""))
+	v.NotifyObservers(observer.NewNotification("NamespaceTestAuthz/login", &vo, ""))
+
+	// test assertions
+	if len(received) != 1 || received[0] != "NamespaceTestAuth/login" {
+		t.Error("Expecting received == [NamespaceTestAuth/login], got ", received)
+	}
+	if vo.Count != 1 || vo.Name != auth.Name("login") {
+		t.Error("Expecting the prefix command to run once for NamespaceTestAuth/login")
+	}
+}
@@ -0,0 +1,56 @@
+//
+//  NotificationBuilder_test.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package observer
+
+import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
+	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/facade"
+	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
+	"testing"
+)
+
+/*
+Test the PureMVC NotificationBuilder class.
+*/
+
+/*
+Tests that a built and sent Notification is delivered
+identically to the equivalent SendNotification call.
+*/
+func TestBuildAndSend(t *testing.T) {
+	var f = facade.GetInstance(func() interfaces.IFacade { return &facade.Facade{} })
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var received []interfaces.INotification
+	var context = &Test{}
+	v.RegisterObserver("BuilderTestNote", &observer.Observer{Notify: func(note interfaces.INotification) {
+		received = append(received, note)
+	}, Context: context})
+
+	var body = &Test{Var: 7}
+	observer.Build("BuilderTestNote").WithBody(body).WithType("built").Send(f)
+	f.SendNotification("BuilderTestNote", body, "built")
+
+	// test assertions
+	if len(received) != 2 {
+		t.Fatal("Expecting len(received) == 2")
+	}
+	if received[0].Name() != received[1].Name() {
+		t.Error("Expecting built name == sent name")
+	}
+	if received[0].Body() != received[1].Body() {
+		t.Error("Expecting built body == sent body")
+	}
+	if received[0].Type() != received[1].Type() {
+		t.Error("Expecting built type == sent type")
+	}
+
+	v.RemoveObserver("BuilderTestNote", context)
+}
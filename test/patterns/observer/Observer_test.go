@@ -9,6 +9,7 @@
 package observer
 
 import (
+	"github.com/puremvc/puremvc-go-standard-framework/src/core/view"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/observer"
 	"testing"
@@ -93,6 +94,57 @@ func TestCompareNotifyContext(t *testing.T) {
 	}
 }
 
+/*
+Tests the equals method of the Observer class
+*/
+func TestEquals(t *testing.T) {
+	// Create observers sharing a context but not a notification method
+	var test = &Test{}
+	var obs = &observer.Observer{Notify: test.NotifyMethod, Context: test}
+	var same = &observer.Observer{Notify: test.NotifyMethod, Context: test}
+	var other = &observer.Observer{Notify: test.ResetMethod, Context: test}
+
+	// test assertions
+	if !obs.Equals(obs) {
+		t.Error("Expecting obs.Equals(obs)")
+	}
+	if obs.Equals(same) {
+		t.Error("Expecting distinct observers of the same method and context not to be equal")
+	}
+	if obs.Equals(other) {
+		t.Error("Expecting obs.Equals(other) == false")
+	}
+	if obs.Equals(&observer.Observer{Notify: test.NotifyMethod, Context: &Test{}}) {
+		t.Error("Expecting observers with different contexts not to be equal")
+	}
+}
+
+/*
+Tests that an Observer created from a method is removed by its receiver.
+*/
+func TestFromMethod(t *testing.T) {
+	var v = view.GetInstance(func() interfaces.IView { return &view.View{} })
+
+	var test = &Test{}
+	v.RegisterObserver("ObserverFromMethodTest", observer.FromMethod(test, test.NotifyMethod))
+
+	v.NotifyObservers(observer.NewNotification("ObserverFromMethodTest", 10, ""))
+	if test.Var != 10 {
+		t.Error("Expecting test.Var == 10")
+	}
+
+	v.RemoveObserver("ObserverFromMethodTest", test)
+	v.NotifyObservers(observer.NewNotification("ObserverFromMethodTest", 20, ""))
+
+	// test assertions
+	if test.Var != 10 {
+		t.Error("Expecting test.Var == 10 after removal")
+	}
+	if v.HasObservers("ObserverFromMethodTest") {
+		t.Error("Expecting no observers left for ObserverFromMethodTest")
+	}
+}
+
 type Test struct {
 	Var int
 }
@@ -106,4 +158,12 @@ func (o *Test) NotifyMethod(note interfaces.INotification) {
 	o.Var = note.Body().(int)
 }
 
+/*
+  A second notification method, used to tell observers
+  sharing a context apart.
+*/
+func (o *Test) ResetMethod(note interfaces.INotification) {
+	o.Var = 0
+}
+
 type NegTestObj struct{}
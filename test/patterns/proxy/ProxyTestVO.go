@@ -0,0 +1,47 @@
+//
+//  ProxyTestVO.go
+//  PureMVC Go Standard
+//
+//  Copyright(c) 2019 Saad Shams <saad.shams@puremvc.org>
+//  Your reuse is governed by the Creative Commons Attribution 3.0 License
+//
+
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+ProxyTestVO A utility class used by ProxyTest.
+*/
+type ProxyTestVO struct {
+	Name  string
+	Count int
+}
+
+/*
+marshalProxyTestVO A trivial codec encoding a ProxyTestVO as "name:count".
+*/
+func marshalProxyTestVO(data interface{}) ([]byte, error) {
+	vo := data.(ProxyTestVO)
+	return []byte(fmt.Sprintf("%s:%d", vo.Name, vo.Count)), nil
+}
+
+/*
+unmarshalProxyTestVO A trivial codec decoding a ProxyTestVO from "name:count".
+*/
+func unmarshalProxyTestVO(input []byte) (interface{}, error) {
+	name, count, found := strings.Cut(string(input), ":")
+	if !found {
+		return nil, errors.New("missing ':' separator")
+	}
+	value, err := strconv.Atoi(count)
+	if err != nil {
+		return nil, err
+	}
+	return ProxyTestVO{Name: name, Count: value}, nil
+}
@@ -9,8 +9,11 @@
 package proxy
 
 import (
+	"encoding/json"
 	"github.com/puremvc/puremvc-go-standard-framework/src/interfaces"
 	"github.com/puremvc/puremvc-go-standard-framework/src/patterns/proxy"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -85,3 +88,181 @@ func TestConstructor(t *testing.T) {
 		t.Error("Expecting data[2] == 'blue'")
 	}
 }
+
+/*
+Tests round-tripping a Proxy through JSON.
+*/
+func TestJSON(t *testing.T) {
+	var p = &proxy.Proxy{Name: "colors", Data: &[]string{"red", "green", "blue"}}
+	var encoded, err = json.Marshal(p)
+	if err != nil {
+		t.Fatal("Expecting err == nil, got ", err)
+	}
+
+	// decode into a proxy holding data of the same type
+	var restored = &proxy.Proxy{Data: &[]string{}}
+	if err = json.Unmarshal(encoded, restored); err != nil {
+		t.Fatal("Expecting err == nil, got ", err)
+	}
+
+	// test assertions
+	if restored.GetProxyName() != "colors" {
+		t.Error("Expecting restored.GetProxyName() == 'colors'")
+	}
+	if data := *restored.GetData().(*[]string); len(data) != 3 || data[2] != "blue" {
+		t.Error("Expecting restored.GetData() == &[red green blue]")
+	}
+
+	// a named proxy keeps its name and rejects another proxy's encoding
+	var named = &proxy.Proxy{Name: "shapes", Data: &[]string{}}
+	if err = json.Unmarshal(encoded, named); err == nil {
+		t.Error("Expecting an error restoring 'shapes' from 'colors'")
+	}
+	if named.GetProxyName() != "shapes" || len(*named.GetData().(*[]string)) != 0 {
+		t.Error("Expecting named to be left unchanged")
+	}
+}
+
+/*
+Tests that a LazyProxy loads its data once across concurrent accesses.
+*/
+func TestLazyProxy(t *testing.T) {
+	var loads int32
+	var p interfaces.IProxy = proxy.NewLazyProxy("lazy", func() interface{} {
+		atomic.AddInt32(&loads, 1)
+		return []string{"red", "green", "blue"}
+	})
+
+	// the data is not loaded until accessed
+	if atomic.LoadInt32(&loads) != 0 {
+		t.Error("Expecting loads == 0 before GetData")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if len(p.GetData().([]string)) != 3 {
+				t.Error("Expecting len(p.GetData()) == 3")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// test assertions
+	if atomic.LoadInt32(&loads) != 1 {
+		t.Error("Expecting loads == 1, got ", loads)
+	}
+}
+
+/*
+Tests that only the compare-and-set writes at the current version succeed.
+*/
+func TestStressVersionedProxy(t *testing.T) {
+	var p = proxy.NewVersionedProxy("versioned", 0)
+
+	// two writers racing from the same version: one wins
+	var wins int32
+	var wg sync.WaitGroup
+	for i := 1; i <= 2; i++ {
+		wg.Add(1)
+		go func(value int) {
+			defer wg.Done()
+			if p.CompareAndSetData(0, value) {
+				atomic.AddInt32(&wins, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if wins != 1 || p.Version() != 1 {
+		t.Error("Expecting a single winning write at version 0")
+	}
+
+	// writers retrying on conflicts lose no update
+	p.SetData(0)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				for {
+					data, version := p.GetDataVersion()
+					if p.CompareAndSetData(version, data.(int)+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// test assertions
+	if p.GetData() != 400 || p.Version() != 402 {
+		t.Error("Expecting data == 400 at version 402, got ", p.GetData(), " at ", p.Version())
+	}
+	if p.CompareAndSetData(0, -1) {
+		t.Error("Expecting a stale write to fail")
+	}
+}
+
+/*
+Tests that a VersionedProxy is serialized under its lock and that
+restoring it increments its version.
+*/
+func TestStressVersionedProxyJSON(t *testing.T) {
+	var p = proxy.NewVersionedProxy("versionedJSON", 0)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= 100; i++ {
+			p.SetData(i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			if _, err := json.Marshal(p); err != nil {
+				t.Error("Expecting err == nil, got ", err)
+			}
+		}
+	}()
+	wg.Wait()
+
+	var restored = proxy.NewVersionedProxy("versionedJSON", 0)
+	var encoded, _ = json.Marshal(p)
+	if err := json.Unmarshal(encoded, restored); err != nil {
+		t.Fatal("Expecting err == nil, got ", err)
+	}
+
+	// test assertions
+	if restored.GetData() != 100 || restored.Version() != 1 {
+		t.Error("Expecting data == 100 at version 1, got ", restored.GetData(), " at ", restored.Version())
+	}
+}
+
+/*
+Tests round-tripping a struct through a CodecProxy with a trivial codec.
+*/
+func TestCodecProxy(t *testing.T) {
+	var p = proxy.NewCodecProxy("codec", marshalProxyTestVO, unmarshalProxyTestVO)
+	p.SetData(ProxyTestVO{Name: "apples", Count: 3})
+
+	var encoded, err = p.Bytes()
+	if err != nil || string(encoded) != "apples:3" {
+		t.Error("Expecting encoded == 'apples:3', got ", string(encoded), err)
+	}
+
+	var restored = proxy.NewCodecProxy("codec", marshalProxyTestVO, unmarshalProxyTestVO)
+
+	// test assertions
+	if err := restored.Load(encoded); err != nil || restored.GetData() != (ProxyTestVO{Name: "apples", Count: 3}) {
+		t.Error("Expecting the restored data to equal the original, got ", restored.GetData(), err)
+	}
+	if err := restored.Load([]byte("invalid")); err == nil || restored.GetData() != (ProxyTestVO{Name: "apples", Count: 3}) {
+		t.Error("Expecting a decoding error to leave the data unchanged")
+	}
+}